@@ -19,6 +19,15 @@ type App struct {
 	Icon            string `json:"icon"`
 }
 
+// AppField is the definition of a field in an app
+type AppField struct {
+	Id         int64  `json:"field_id"`
+	ExternalId string `json:"external_id"`
+	Type       string `json:"type"`
+	Label      string `json:"label"`
+	Status     string `json:"status"` // active or deleted
+}
+
 // https://developers.podio.com/doc/applications/get-apps-by-space-22478
 func (client *Client) GetApps(spaceId int64) (apps []App, err error) {
 	path := fmt.Sprintf("/app/space/%d?view=micro", spaceId)
@@ -39,3 +48,15 @@ func (client *Client) GetAppBySpaceIdAndSlug(spaceId int64, slug string) (app *A
 	err = client.Request("GET", path, nil, nil, &app)
 	return
 }
+
+// GetAppFields returns the definitions of the fields in an app, in the order they are shown.
+func (client *Client) GetAppFields(appId int64) ([]*AppField, error) {
+	path := fmt.Sprintf("/app/%d", appId)
+
+	rsp := &struct {
+		Fields []*AppField `json:"fields"`
+	}{}
+	err := client.Request("GET", path, nil, nil, rsp)
+
+	return rsp.Fields, err
+}
@@ -0,0 +1,85 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BatchStatus is the state of a batch job
+type BatchStatus string
+
+const (
+	BatchCreated   BatchStatus = "created"
+	BatchStarted   BatchStatus = "started"
+	BatchCompleted BatchStatus = "completed"
+	BatchFailed    BatchStatus = "failed"
+)
+
+// Batch is a job podio runs in the background, e.g. an import
+type Batch struct {
+	Id        int64       `json:"batch_id"`
+	Name      string      `json:"name"`
+	Plugin    string      `json:"plugin"`
+	Status    BatchStatus `json:"status"`
+	File      *File       `json:"file"`
+	App       *App        `json:"app"`
+	CreatedBy ByLine      `json:"created_by"`
+	CreatedOn Time        `json:"created_on"`
+	StartedOn *Time       `json:"started_on"`
+	EndedOn   *Time       `json:"ended_on"`
+
+	// The number of rows processed so far
+	Completed int `json:"completed"`
+	Skipped   int `json:"skipped"`
+	Failed    int `json:"failed"`
+}
+
+// Done reports whether the batch has completed or failed
+func (batch *Batch) Done() bool {
+	return batch.Status == BatchCompleted || batch.Status == BatchFailed
+}
+
+// GetBatch returns the current state of a batch
+func (client *Client) GetBatch(batchId int64) (batch *Batch, err error) {
+	path := fmt.Sprintf("/batch/%d", batchId)
+	err = client.Request("GET", path, nil, nil, &batch)
+	return
+}
+
+// GetBatches returns the batches of the active user, newest first.
+// The page is controlled with limit and offset in the params map.
+func (client *Client) GetBatches(params map[string]interface{}) (batches []*Batch, err error) {
+	err = client.RequestWithParams("GET", "/batch/", nil, params, &batches)
+	return
+}
+
+// WaitForBatch polls a batch every interval (5 seconds if not positive) until
+// it is done or ctx is done, and returns its final state. progress, if not
+// nil, is called with the batch after every poll.
+func (client *Client) WaitForBatch(ctx context.Context, batchId int64, interval time.Duration, progress func(*Batch)) (*Batch, error) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		batch, err := client.GetBatch(batchId)
+		if err != nil {
+			return nil, err
+		}
+		if progress != nil {
+			progress(batch)
+		}
+		if batch.Done() {
+			return batch, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return batch, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// BulkOpType is the kind of write a BulkOp makes
+type BulkOpType string
+
+const (
+	BulkCreate BulkOpType = "create"
+	BulkUpdate BulkOpType = "update"
+
+	// BulkUpsert updates the item with the external id of the op, or
+	// creates it if there is none
+	BulkUpsert BulkOpType = "upsert"
+)
+
+// BulkOp is a single write of a BulkWriter
+type BulkOp struct {
+	Type       BulkOpType
+	AppId      int64  // for create and upsert
+	ItemId     int64  // for update
+	ExternalId string // required for upsert, optional for create
+	Fields     map[string]interface{}
+}
+
+// key identifies the item the op writes, if known
+func (op BulkOp) key() string {
+	switch {
+	case op.Type == BulkUpdate:
+		return fmt.Sprintf("item:%d", op.ItemId)
+	case op.ExternalId != "":
+		return fmt.Sprintf("external_id:%d:%s", op.AppId, op.ExternalId)
+	}
+	return ""
+}
+
+// BulkResult is the outcome of a BulkOp
+type BulkResult struct {
+	// Index is the position of the op in the input
+	Index int
+	Op    BulkOp
+
+	ItemId int64
+	// Created is whether the op created the item. It is false for a create
+	// retried as an upsert which finds the item created by an earlier attempt.
+	Created  bool
+	Attempts int
+	Err      error
+}
+
+// BulkReport holds the results of a run of a BulkWriter in the order of the ops
+type BulkReport struct {
+	Results []*BulkResult
+}
+
+// Failed returns the results of the ops which failed
+func (report *BulkReport) Failed() (failed []*BulkResult) {
+	for _, result := range report.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// FailedOps returns the ops which failed, in order, so they can be run again
+// to resume after a failure or cancellation.
+func (report *BulkReport) FailedOps() (ops []BulkOp) {
+	for _, result := range report.Failed() {
+		ops = append(ops, result.Op)
+	}
+	return
+}
+
+// BulkWriter runs item writes on a bounded pool of workers.
+//
+// Ops on the same item (by item id or external id) are run by the same worker
+// in the order they are given, so they never race. Failures caused by rate
+// limiting, unavailability or the network are retried with exponential
+// backoff; when podio reports the rate limit is hit, all workers pause.
+//
+// A create failing with a network error or a server error may still have
+// created the item, so it is only retried if it has an external id, as an
+// upsert which finds the item if so. Creates without one are not retried.
+type BulkWriter struct {
+	// Workers is the number of concurrent requests. Defaults to 4.
+	Workers int
+
+	// MaxAttempts is the number of times an op is tried. Defaults to 5.
+	MaxAttempts int
+
+	// RetryDelay is the delay before the first retry of an op, doubled for every
+	// following retry up to MaxRetryDelay. Defaults to 1s and 1m.
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+
+	// OnResult, if not nil, is called with every result as soon as it is
+	// known, e.g. to persist progress. It is called from the workers and
+	// must be safe for concurrent use.
+	OnResult func(*BulkResult)
+
+	// UpsertOptions are used for upserts, see UpsertItemByExternalID. May be nil.
+	UpsertOptions *UpsertOptions
+
+	// ErrorLog receives retried errors. If nil, they are not logged.
+	ErrorLog *log.Logger
+
+	client *Client
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func NewBulkWriter(client *Client) *BulkWriter {
+	return &BulkWriter{
+		Workers:       4,
+		MaxAttempts:   5,
+		RetryDelay:    time.Second,
+		MaxRetryDelay: time.Minute,
+		client:        client,
+	}
+}
+
+// Write runs ops and returns the report once all are done.
+func (bw *BulkWriter) Write(ctx context.Context, ops []BulkOp) *BulkReport {
+	stream := make(chan BulkOp)
+	go func() {
+		defer close(stream)
+		for _, op := range ops {
+			stream <- op
+		}
+	}()
+	return bw.Run(ctx, stream)
+}
+
+// Run runs the ops received on ops until it is closed and returns the report.
+// When ctx is done, the remaining ops are not run but reported with the error
+// of ctx; ops must still be closed.
+func (bw *BulkWriter) Run(ctx context.Context, ops <-chan BulkOp) *BulkReport {
+	workers := bw.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	report := &BulkReport{}
+
+	queues := make([]chan *BulkResult, workers)
+	var wg sync.WaitGroup
+	for i := range queues {
+		queues[i] = make(chan *BulkResult, workers)
+		wg.Add(1)
+		go func(queue <-chan *BulkResult) {
+			defer wg.Done()
+			for result := range queue {
+				bw.run(ctx, result)
+				if bw.OnResult != nil {
+					bw.OnResult(result)
+				}
+			}
+		}(queues[i])
+	}
+
+	index := 0
+	for op := range ops {
+		result := &BulkResult{Index: index, Op: op}
+		report.Results = append(report.Results, result)
+
+		// ops on the same item go to the same worker to keep their order
+		worker := index % workers
+		if key := op.key(); key != "" {
+			h := fnv.New32a()
+			h.Write([]byte(key))
+			worker = int(h.Sum32() % uint32(workers))
+		}
+		queues[worker] <- result
+		index++
+	}
+
+	for _, queue := range queues {
+		close(queue)
+	}
+	wg.Wait()
+
+	return report
+}
+
+// run runs the op of result, retrying as configured, and records the outcome in result
+func (bw *BulkWriter) run(ctx context.Context, result *BulkResult) {
+	op := result.Op
+	delay := bw.RetryDelay
+	for {
+		if err := bw.wait(ctx); err != nil {
+			result.Err = err
+			return
+		}
+
+		result.Attempts++
+		result.ItemId, result.Created, result.Err = bw.write(op)
+
+		retry, wait := retryable(result.Err)
+		if retry && op.Type == BulkCreate && ambiguous(result.Err) {
+			if op.ExternalId == "" {
+				return
+			}
+			op.Type = BulkUpsert
+		}
+		if !retry || result.Attempts >= bw.MaxAttempts {
+			return
+		}
+		logf(bw.ErrorLog, "podio: retrying %s of op %d after error: %s", result.Op.Type, result.Index, result.Err)
+
+		if wait == 0 {
+			wait = delay
+			if delay *= 2; delay > bw.MaxRetryDelay {
+				delay = bw.MaxRetryDelay
+			}
+			if err := sleep(ctx, wait); err != nil {
+				result.Err = err
+				return
+			}
+			continue
+		}
+
+		// rate limited: pause all workers
+		bw.mu.Lock()
+		if until := time.Now().Add(wait); until.After(bw.pausedUntil) {
+			bw.pausedUntil = until
+		}
+		bw.mu.Unlock()
+	}
+}
+
+// wait waits until the workers are no longer paused
+func (bw *BulkWriter) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	bw.mu.Lock()
+	until := bw.pausedUntil
+	bw.mu.Unlock()
+	return sleep(ctx, time.Until(until))
+}
+
+func (bw *BulkWriter) write(op BulkOp) (itemId int64, created bool, err error) {
+	switch op.Type {
+	case BulkCreate:
+		itemId, err = bw.client.CreateItem(int(op.AppId), op.ExternalId, op.Fields)
+		return itemId, true, err
+	case BulkUpdate:
+		return op.ItemId, false, bw.client.UpdateItem(int(op.ItemId), op.Fields)
+	case BulkUpsert:
+		itemId, action, err := bw.client.UpsertItemByExternalID(op.AppId, op.ExternalId, op.Fields, bw.UpsertOptions)
+		return itemId, action == UpsertCreated, err
+	}
+	return 0, false, fmt.Errorf("unknown bulk op type %q", op.Type)
+}
+
+var rateLimitWait = regexp.MustCompile(`wait (\d+) seconds`)
+
+// retryable reports whether a request failing with err may succeed if retried.
+// For rate limiting, wait is how long podio asked to wait (at least a second).
+func retryable(err error) (retry bool, wait time.Duration) {
+	switch err := err.(type) {
+	case nil:
+		return false, 0
+	case *Error:
+		switch err.Type {
+		case "rate_limit":
+			wait = time.Second
+			if m := rateLimitWait.FindStringSubmatch(err.Description); m != nil {
+				if seconds, _ := strconv.Atoi(m[1]); seconds > 0 {
+					wait = time.Duration(seconds) * time.Second
+				}
+			}
+			return true, wait
+		case "unavailable":
+			return true, 0
+		}
+	case *HTTPError:
+		switch {
+		case err.StatusCode == 420 || err.StatusCode == 429:
+			return true, time.Second
+		case err.StatusCode >= 500:
+			return true, 0
+		}
+	case net.Error:
+		return true, 0
+	}
+	return false, 0
+}
+
+// ambiguous reports whether a write failing with err may have been made
+// anyway, e.g. if the connection broke or a proxy timed out after podio
+// received the request.
+func ambiguous(err error) bool {
+	switch err := err.(type) {
+	case *HTTPError:
+		return err.StatusCode >= 500
+	case net.Error:
+		return true
+	}
+	return false
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
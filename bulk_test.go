@@ -0,0 +1,179 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// itemServer stores items created and updated through it by external id.
+// The first request for each external id fails as unavailable.
+type itemServer struct {
+	mu       sync.Mutex
+	nextId   int64
+	items    map[string]int64
+	updates  map[int64]int
+	failures map[string]bool
+}
+
+func newItemServer() *itemServer {
+	return &itemServer{nextId: 100, items: map[string]int64{}, updates: map[int64]int{}, failures: map[string]bool{}}
+}
+
+func (s *itemServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	params := struct {
+		ExternalId string `json:"external_id"`
+	}{}
+	json.NewDecoder(req.Body).Decode(&params)
+
+	key := params.ExternalId
+	if i := strings.Index(req.URL.Path, "/external_id/"); i >= 0 {
+		key = req.URL.Path[i+len("/external_id/"):]
+	}
+	if key != "" && !s.failures[key] {
+		s.failures[key] = true
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error": "unavailable", "error_description": "try again"}`))
+		return
+	}
+
+	switch {
+	case req.Method == "GET":
+		id, ok := s.items[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "not_found", "error_description": "no item"}`))
+			return
+		}
+		fmt.Fprintf(w, `{"item_id": %d}`, id)
+	case req.Method == "POST":
+		s.nextId++
+		s.items[params.ExternalId] = s.nextId
+		fmt.Fprintf(w, `{"item_id": %d}`, s.nextId)
+	case req.Method == "PUT":
+		var id int64
+		fmt.Sscanf(req.URL.Path, "/item/%d", &id)
+		s.updates[id]++
+		w.Write([]byte(`{}`))
+	}
+}
+
+func TestBulkWriter(t *testing.T) {
+	r := require.New(t)
+
+	server := newItemServer()
+	client := newTestClient(server.ServeHTTP)
+	writer := NewBulkWriter(client)
+	writer.RetryDelay = time.Millisecond
+
+	var ops []BulkOp
+	for i := 0; i < 20; i++ {
+		ops = append(ops, BulkOp{Type: BulkUpsert, AppId: 1, ExternalId: fmt.Sprint("ext-", i%10)})
+	}
+	ops = append(ops, BulkOp{Type: BulkUpdate, ItemId: 101})
+
+	report := writer.Write(context.Background(), ops)
+	r.Empty(report.Failed())
+	r.Len(report.Results, 21)
+	for i, result := range report.Results {
+		r.Equal(i, result.Index)
+		r.Equal(ops[i], result.Op)
+	}
+
+	// the first upsert of each external id creates the item, the second updates it
+	r.Len(server.items, 10)
+	for i := 0; i < 10; i++ {
+		first, second := report.Results[i], report.Results[i+10]
+		r.True(first.Created)
+		r.Equal(2, first.Attempts)
+		r.False(second.Created)
+		r.Equal(first.ItemId, second.ItemId)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report = writer.Write(ctx, ops[:3])
+	r.Len(report.Failed(), 3)
+	r.Equal(ops[:3], report.FailedOps())
+	r.Equal(context.Canceled, report.Results[0].Err)
+}
+
+func TestBulkWriterAmbiguousCreate(t *testing.T) {
+	r := require.New(t)
+
+	// the item is created, but the response is lost to a gateway error
+	var creates int
+	items := map[string]int64{}
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		params := struct {
+			ExternalId string `json:"external_id"`
+		}{}
+		json.NewDecoder(req.Body).Decode(&params)
+
+		switch {
+		case req.Method == "POST":
+			creates++
+			items[params.ExternalId] = int64(100 + creates)
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html>Bad gateway</html>"))
+		case req.Method == "GET":
+			id, ok := items[strings.TrimPrefix(req.URL.Path, "/item/app/1/external_id/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error": "not_found", "error_description": "no item"}`))
+				return
+			}
+			fmt.Fprintf(w, `{"item_id": %d}`, id)
+		}
+	})
+	writer := NewBulkWriter(client)
+	writer.Workers = 1
+	writer.RetryDelay = time.Millisecond
+
+	report := writer.Write(context.Background(), []BulkOp{
+		{Type: BulkCreate, AppId: 1, ExternalId: "ext"},
+		{Type: BulkCreate, AppId: 1},
+	})
+
+	// the create with an external id is retried as an upsert finding the item
+	withId := report.Results[0]
+	r.NoError(withId.Err)
+	r.Equal(2, withId.Attempts)
+	r.Equal(int64(101), withId.ItemId)
+	r.Equal(BulkCreate, withId.Op.Type)
+
+	// the create without one is not retried
+	withoutId := report.Results[1]
+	r.IsType(&HTTPError{}, withoutId.Err)
+	r.Equal(1, withoutId.Attempts)
+
+	r.Equal(2, creates)
+}
+
+func TestRetryable(t *testing.T) {
+	r := require.New(t)
+
+	retry, wait := retryable(&Error{Type: "rate_limit", Description: "You have hit the rate limit. Please wait 300 seconds before trying again"})
+	r.True(retry)
+	r.Equal(300*time.Second, wait)
+
+	retry, wait = retryable(&Error{Type: "unavailable"})
+	r.True(retry)
+	r.Zero(wait)
+
+	retry, _ = retryable(&HTTPError{StatusCode: http.StatusBadGateway})
+	r.True(retry)
+
+	retry, _ = retryable(&Error{Type: "invalid_value"})
+	r.False(retry)
+}
@@ -0,0 +1,222 @@
+package podio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CalendarEvent is an entry on a Podio calendar, e.g. a task or the value of an item date field
+type CalendarEvent struct {
+	UID         string  `json:"uid"`
+	RefType     RefType `json:"ref_type"`
+	RefId       int64   `json:"ref_id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Location    string  `json:"location"`
+	Status      string  `json:"status"`
+	Link        string  `json:"link"`
+	Color       string  `json:"color"`
+	Busy        bool    `json:"busy"`
+	Version     int     `json:"version"`
+	App         *App    `json:"app"`
+
+	Start *Time `json:"start_utc"`
+	End   *Time `json:"end_utc"`
+
+	// The dates (2006-01-02) and times (15:04:05) of the event in the local time of the user.
+	// The times are empty for all-day events.
+	StartDate string `json:"start_date"`
+	StartTime string `json:"start_time"`
+	EndDate   string `json:"end_date"`
+	EndTime   string `json:"end_time"`
+}
+
+// AllDay reports whether the event has no time of day
+func (e *CalendarEvent) AllDay() bool {
+	return e.StartTime == ""
+}
+
+const calendarDateLayout = "2006-01-02"
+
+func calendarParams(dateFrom, dateTo time.Time, params map[string]interface{}) map[string]interface{} {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["date_from"] = dateFrom.Format(calendarDateLayout)
+	params["date_to"] = dateTo.Format(calendarDateLayout)
+	return params
+}
+
+// GetCalendar returns the events between dateFrom and dateTo (both inclusive) on all
+// the calendars of the active user. Additional filters (types, space_ids, ...) can be set in the params map.
+//
+// https://developers.podio.com/doc/calendar
+func (client *Client) GetCalendar(dateFrom, dateTo time.Time, params map[string]interface{}) (events []*CalendarEvent, err error) {
+	params = calendarParams(dateFrom, dateTo, params)
+	err = client.RequestWithParams("GET", "/calendar/", nil, params, &events)
+	return
+}
+
+// GetSpaceCalendar returns the events between dateFrom and dateTo on the calendar of a space.
+//
+// https://developers.podio.com/doc/calendar
+func (client *Client) GetSpaceCalendar(spaceId int64, dateFrom, dateTo time.Time, params map[string]interface{}) (events []*CalendarEvent, err error) {
+	path := fmt.Sprintf("/calendar/space/%d/", spaceId)
+	params = calendarParams(dateFrom, dateTo, params)
+	err = client.RequestWithParams("GET", path, nil, params, &events)
+	return
+}
+
+// GetAppCalendar returns the events between dateFrom and dateTo on the calendar of an app.
+//
+// https://developers.podio.com/doc/calendar
+func (client *Client) GetAppCalendar(appId int64, dateFrom, dateTo time.Time, params map[string]interface{}) (events []*CalendarEvent, err error) {
+	path := fmt.Sprintf("/calendar/app/%d/", appId)
+	params = calendarParams(dateFrom, dateTo, params)
+	err = client.RequestWithParams("GET", path, nil, params, &events)
+	return
+}
+
+// GetItemCalendarEvents returns the calendar events of an item, one for each
+// value of the date fields shown on the calendar.
+//
+// https://developers.podio.com/doc/calendar
+func (client *Client) GetItemCalendarEvents(itemId int64) (events []*CalendarEvent, err error) {
+	path := fmt.Sprintf("/calendar/item/%d/", itemId)
+	err = client.Request("GET", path, nil, nil, &events)
+	return
+}
+
+// The iCal feeds are authenticated by the user id and the calendar token shown
+// in the calendar export settings of the user, rather than by an OAuth token,
+// so the URLs can be handed to any calendar application.
+
+// GlobalCalendarICalURL returns the iCal feed URL of all the calendars of a user.
+func GlobalCalendarICalURL(userId int64, token string) string {
+	return fmt.Sprintf("https://api.podio.com/calendar/ics/%d/%s/", userId, token)
+}
+
+// SpaceCalendarICalURL returns the iCal feed URL of the calendar of a space.
+func SpaceCalendarICalURL(spaceId, userId int64, token string) string {
+	return fmt.Sprintf("https://api.podio.com/calendar/space/%d/ics/%d/%s/", spaceId, userId, token)
+}
+
+// AppCalendarICalURL returns the iCal feed URL of the calendar of an app.
+func AppCalendarICalURL(appId, userId int64, token string) string {
+	return fmt.Sprintf("https://api.podio.com/calendar/app/%d/ics/%d/%s/", appId, userId, token)
+}
+
+const (
+	icalDateLayout     = "20060102"
+	icalDateTimeLayout = "20060102T150405Z"
+)
+
+// WriteICalendar writes events as an RFC 5545 iCalendar with the given name to w.
+func WriteICalendar(w io.Writer, name string, events []*CalendarEvent) error {
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		bw.WriteString(foldICalLine(s))
+		bw.WriteString("\r\n")
+	}
+
+	stamp := time.Now().UTC().Format(icalDateTimeLayout)
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//podio-go//Podio Calendar//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	if name != "" {
+		line("X-WR-CALNAME:" + escapeICalText(name))
+	}
+
+	for _, e := range events {
+		uid := e.UID
+		if uid == "" {
+			uid = fmt.Sprintf("%s-%d@podio.com", e.RefType, e.RefId)
+		}
+
+		line("BEGIN:VEVENT")
+		line("UID:" + escapeICalText(uid))
+		line("DTSTAMP:" + stamp)
+
+		if e.AllDay() {
+			start, err := time.Parse(calendarDateLayout, e.StartDate)
+			if err != nil {
+				return fmt.Errorf("event %s has invalid start date %q: %v", uid, e.StartDate, err)
+			}
+			end := start
+			if e.EndDate != "" {
+				if end, err = time.Parse(calendarDateLayout, e.EndDate); err != nil {
+					return fmt.Errorf("event %s has invalid end date %q: %v", uid, e.EndDate, err)
+				}
+			}
+			// the end date of all-day events is exclusive
+			line("DTSTART;VALUE=DATE:" + start.Format(icalDateLayout))
+			line("DTEND;VALUE=DATE:" + end.AddDate(0, 0, 1).Format(icalDateLayout))
+		} else {
+			if e.Start == nil {
+				return fmt.Errorf("event %s has no start time", uid)
+			}
+			line("DTSTART:" + e.Start.UTC().Format(icalDateTimeLayout))
+			if e.End != nil && !e.End.IsZero() {
+				line("DTEND:" + e.End.UTC().Format(icalDateTimeLayout))
+			}
+		}
+
+		line("SUMMARY:" + escapeICalText(e.Title))
+		if e.Description != "" {
+			line("DESCRIPTION:" + escapeICalText(e.Description))
+		}
+		if e.Location != "" {
+			line("LOCATION:" + escapeICalText(e.Location))
+		}
+		if e.Link != "" {
+			line("URL:" + e.Link)
+		}
+		if e.Busy {
+			line("TRANSP:OPAQUE")
+		} else {
+			line("TRANSP:TRANSPARENT")
+		}
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+// escapeICalText escapes a TEXT value as described in RFC 5545 section 3.3.11
+func escapeICalText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// foldICalLine splits lines longer than 75 octets as described in RFC 5545
+// section 3.1, without splitting multi-byte characters.
+func foldICalLine(s string) string {
+	const limit = 75
+
+	var b strings.Builder
+	lineLen := 0
+	for _, r := range s {
+		n := utf8.RuneLen(r)
+		if lineLen+n > limit {
+			b.WriteString("\r\n ")
+			// the leading space counts towards the length of the line
+			lineLen = 1
+		}
+		b.WriteRune(r)
+		lineLen += n
+	}
+	return b.String()
+}
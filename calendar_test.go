@@ -0,0 +1,91 @@
+package podio
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteICalendar(t *testing.T) {
+	r := require.New(t)
+
+	start := Time{time.Date(2016, 3, 1, 9, 30, 0, 0, time.UTC)}
+	end := Time{time.Date(2016, 3, 1, 10, 0, 0, 0, time.UTC)}
+
+	events := []*CalendarEvent{
+		{
+			UID:         "item-1-field-2",
+			RefType:     "item",
+			RefId:       1,
+			Title:       "Meeting; agenda, notes",
+			Description: "line one\nline two",
+			Link:        "https://podio.com/x/y/items/1",
+			Busy:        true,
+			Start:       &start,
+			End:         &end,
+			StartDate:   "2016-03-01",
+			StartTime:   "10:30:00",
+		},
+		{
+			RefType:   "task",
+			RefId:     7,
+			Title:     "Deadline",
+			StartDate: "2016-03-04",
+			EndDate:   "2016-03-05",
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	r.NoError(WriteICalendar(buf, "Team", events))
+
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if !strings.HasPrefix(line, "DTSTAMP:") {
+			lines = append(lines, line)
+		}
+	}
+
+	r.Equal([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//podio-go//Podio Calendar//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Team",
+		"BEGIN:VEVENT",
+		"UID:item-1-field-2",
+		"DTSTART:20160301T093000Z",
+		"DTEND:20160301T100000Z",
+		`SUMMARY:Meeting\; agenda\, notes`,
+		`DESCRIPTION:line one\nline two`,
+		"URL:https://podio.com/x/y/items/1",
+		"TRANSP:OPAQUE",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:task-7@podio.com",
+		"DTSTART;VALUE=DATE:20160304",
+		"DTEND;VALUE=DATE:20160306",
+		"SUMMARY:Deadline",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, lines)
+}
+
+func TestFoldICalLine(t *testing.T) {
+	r := require.New(t)
+
+	line := "SUMMARY:" + strings.Repeat("æ", 60)
+	folded := foldICalLine(line)
+
+	parts := strings.Split(folded, "\r\n")
+	r.Len(parts, 2)
+	for _, part := range parts {
+		r.True(len(part) <= 75, "line %q is %d octets", part, len(part))
+	}
+	r.True(strings.HasPrefix(parts[1], " "))
+	r.Equal(line, parts[0]+parts[1][1:])
+}
@@ -3,12 +3,13 @@ package podio
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 type Client struct {
@@ -32,6 +33,18 @@ func (p *Error) Error() string {
 	return fmt.Sprintf("%s: %s", p.Type, p.Description)
 }
 
+// HTTPError is returned for error responses which are not podio API errors,
+// e.g. error pages from the file servers.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	return "unexpected response: " + e.Status
+}
+
 func NewClient(authToken *AuthToken) *Client {
 	return &Client{
 		httpClient: &http.Client{},
@@ -40,17 +53,43 @@ func NewClient(authToken *AuthToken) *Client {
 }
 
 func (client *Client) Request(method string, path string, headers map[string]string, body io.Reader, out interface{}) error {
-	req, err := http.NewRequest(method, "https://api.podio.com"+path, body)
+	req, err := client.newRequest(method, path, headers, body)
 	if err != nil {
 		return err
 	}
 
+	return client.do(req, out)
+}
+
+// newRequest creates a request for path on the API. Absolute https URLs, like
+// the links of files, are requested as is; any other URL is refused so the
+// access token is never sent unencrypted. See send for which hosts get the
+// token at all.
+func (client *Client) newRequest(method string, path string, headers map[string]string, body io.Reader) (*http.Request, error) {
+	var link string
+	switch {
+	case strings.HasPrefix(path, "/"):
+		link = "https://api.podio.com" + path
+	case strings.HasPrefix(path, "https://"):
+		link = path
+	default:
+		return nil, fmt.Errorf("refusing to request %q: not an API path or https URL", path)
+	}
+
+	req, err := http.NewRequest(method, link, body)
+	if err != nil {
+		return nil, err
+	}
+
 	for k, v := range headers {
 		req.Header.Add(k, v)
 	}
+	return req, nil
+}
 
-	req.Header.Add("Authorization", "OAuth2 "+client.authToken.AccessToken)
-	resp, err := client.httpClient.Do(req)
+// do sends req and decodes the response body into out
+func (client *Client) do(req *http.Request, out interface{}) error {
+	resp, err := client.send(req)
 	if err != nil {
 		return err
 	}
@@ -61,13 +100,10 @@ func (client *Client) Request(method string, path string, headers map[string]str
 		return err
 	}
 
-	if !(200 <= resp.StatusCode && resp.StatusCode < 300) {
-		podioErr := &Error{}
-		err := json.Unmarshal(respBody, podioErr)
-		if err != nil {
-			return errors.New(string(respBody))
-		}
-		return podioErr
+	// responses which are not JSON, e.g. vCards, are returned as is
+	if raw, ok := out.(*[]byte); ok {
+		*raw = respBody
+		return nil
 	}
 
 	if out != nil {
@@ -77,6 +113,53 @@ func (client *Client) Request(method string, path string, headers map[string]str
 	return nil
 }
 
+// send authorizes and sends req. The body of a successful response is left
+// for the caller to read and close. Requests to hosts other than podio's,
+// e.g. links of files hosted on dropbox, are sent without the access token.
+func (client *Client) send(req *http.Request) (*http.Response, error) {
+	if isPodioHost(req.URL.Hostname()) {
+		req.Header.Add("Authorization", "OAuth2 "+client.authToken.AccessToken)
+	}
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(200 <= resp.StatusCode && resp.StatusCode < 300) {
+		defer resp.Body.Close()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		podioErr := &Error{}
+		err = json.Unmarshal(respBody, podioErr)
+		if err != nil || podioErr.Type == "" {
+			return nil, &HTTPError{
+				StatusCode: resp.StatusCode,
+				Status:     resp.Status,
+				Body:       respBody,
+			}
+		}
+		return nil, podioErr
+	}
+
+	return resp, nil
+}
+
+// logf logs to l, or nowhere if l is nil
+func logf(l *log.Logger, format string, args ...interface{}) {
+	if l != nil {
+		l.Printf(format, args...)
+	}
+}
+
+// isPodioHost reports whether host is podio.com or one of its subdomains
+func isPodioHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "podio.com" || strings.HasSuffix(host, ".podio.com")
+}
+
 func (client *Client) RequestWithParams(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) error {
     var body io.Reader
 
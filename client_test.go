@@ -0,0 +1,27 @@
+package podio
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil {
+		req.Body = http.NoBody
+	}
+	rec := httptest.NewRecorder()
+	t.handler.ServeHTTP(rec, req)
+	return rec.Result(), nil
+}
+
+// newTestClient returns a client whose requests are served by handler
+// instead of api.podio.com.
+func newTestClient(handler http.HandlerFunc) *Client {
+	client := NewClient(&AuthToken{AccessToken: "token"})
+	client.httpClient = &http.Client{Transport: handlerTransport{handler}}
+	return client
+}
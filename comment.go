@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
 
 // Comment is a comment on an object in podio.
 // The object to which this comment is associated is described in this Reference.
@@ -8,38 +11,129 @@ type Comment struct {
 	Id         int64      `json:"comment_id"`
 	ExternalId string     `json:"external_id"`
 	Value      string     `json:"value"`
+	RichValue  string     `json:"rich_value"`
 	Ref        *Reference `json:"ref"`
 	Files      []*File    `json:"files"`
+	Embed      *Embed     `json:"embed"`
+	EmbedFile  *File      `json:"embed_file"`
 	CreatedBy  ByLine     `json:"created_by"`
 	CreatedVia Via        `json:"created_via"`
 	CreatedOn  Time       `json:"created_on"`
+	LastEditOn *Time      `json:"last_edit_on"`
 	IsLiked    bool       `json:"is_liked"`
 	LikeCount  int        `json:"like_count"`
 }
 
+// CommentOptions are the optional parameters when adding or updating a comment.
+type CommentOptions struct {
+	ExternalId string
+	FileIds    []int64
+	EmbedId    int64
+	EmbedURL   string
+
+	// AlertInvite invites users mentioned in the comment who do not have
+	// access to the object.
+	AlertInvite bool
+
+	// Silent does not bump the object in the stream and sends no notifications.
+	Silent bool
+
+	// NoHook does not trigger the hooks of the object.
+	NoHook bool
+}
+
+// path returns path with the query parameters of the options
+func (opts *CommentOptions) path(path string) string {
+	query := url.Values{}
+	if opts != nil && opts.Silent {
+		query.Set("silent", "true")
+	}
+	if opts != nil && opts.NoHook {
+		query.Set("hook", "false")
+	}
+
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
+
+// params returns the body parameters of the options along with text
+func (opts *CommentOptions) params(text string) map[string]interface{} {
+	params := map[string]interface{}{
+		"value": text,
+	}
+	if opts == nil {
+		return params
+	}
+
+	if opts.ExternalId != "" {
+		params["external_id"] = opts.ExternalId
+	}
+	if opts.FileIds != nil {
+		params["file_ids"] = opts.FileIds
+	}
+	if opts.EmbedId != 0 {
+		params["embed_id"] = opts.EmbedId
+	}
+	if opts.EmbedURL != "" {
+		params["embed_url"] = opts.EmbedURL
+	}
+	if opts.AlertInvite {
+		params["alert_invite"] = true
+	}
+	return params
+}
+
 // Comment adds a comment to a podio object. It returns a Comment (with podio ID) or an error if one occured.
 //
-// refType (item, task, ...) and refId identifies the podio object to which the comment is added.
+// ref identifies the podio object to which the comment is added.
 // text is the actual comment value.
-// Additional parameters can be set in the params map.
-func (client *Client) Comment(refType string, refId int64, text string, params map[string]interface{}) (*Comment, error) {
-	path := fmt.Sprintf("/comment/%s/%d/", refType, refId)
-	if params == nil {
-		params = map[string]interface{}{}
+// opts may be nil.
+func (client *Client) Comment(ref Ref, text string, opts *CommentOptions) (*Comment, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
 	}
-	params["value"] = text
+	path := opts.path(fmt.Sprintf("/comment/%s/%d/", ref.Type, ref.Id))
 
 	comment := &Comment{}
-	err := client.RequestWithParams("POST", path, nil, params, comment)
+	err := client.RequestWithParams("POST", path, nil, opts.params(text), comment)
 	return comment, err
 }
 
-// GetComments retrieves the comments associated with a podio object.
+// GetComments retrieves the comments associated with a podio object, oldest first.
 //
-// refType is the type of the podio object. For legal type values see
-// refId is the podio id of the podio object.
-func (client *Client) GetComments(refType string, refId int64) (comments []*Comment, err error) {
-	path := fmt.Sprintf("/comment/%s/%d/", refType, refId)
-	err = client.Request("GET", path, nil, nil, &comments)
+// ref identifies the podio object.
+// The page is controlled with limit and offset in the params map; by default all comments are returned.
+func (client *Client) GetComments(ref Ref, params map[string]interface{}) (comments []*Comment, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/comment/%s/%d/", ref.Type, ref.Id)
+	err = client.RequestWithParams("GET", path, nil, params, &comments)
 	return
 }
+
+// GetComment returns a single comment.
+func (client *Client) GetComment(commentId int64) (comment *Comment, err error) {
+	path := fmt.Sprintf("/comment/%d", commentId)
+	err = client.Request("GET", path, nil, nil, &comment)
+	return
+}
+
+// UpdateComment replaces the text of a comment. Files and embeds in opts
+// replace those on the comment, while ExternalId and AlertInvite are ignored.
+func (client *Client) UpdateComment(commentId int64, text string, opts *CommentOptions) error {
+	path := opts.path(fmt.Sprintf("/comment/%d", commentId))
+	params := opts.params(text)
+	delete(params, "external_id")
+	delete(params, "alert_invite")
+
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
+// DeleteComment deletes a comment. Only Silent and NoHook of opts are used; opts may be nil.
+func (client *Client) DeleteComment(commentId int64, opts *CommentOptions) error {
+	path := opts.path(fmt.Sprintf("/comment/%d", commentId))
+	return client.Request("DELETE", path, nil, nil, nil)
+}
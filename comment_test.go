@@ -0,0 +1,66 @@
+package podio
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentOptions(t *testing.T) {
+	r := require.New(t)
+
+	var method, uri, body string
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		buf, _ := ioutil.ReadAll(req.Body)
+		method, uri, body = req.Method, req.URL.RequestURI(), string(buf)
+		w.Write([]byte(`{"comment_id": 3, "value": "hi"}`))
+	})
+
+	comment, err := client.Comment(NewRef(RefItem, 1), "hi", nil)
+	r.NoError(err)
+	r.Equal(int64(3), comment.Id)
+	r.Equal("/comment/item/1/", uri)
+	r.JSONEq(`{"value": "hi"}`, body)
+
+	_, err = client.Comment(Ref{RefTask, 2}, "hi", &CommentOptions{
+		ExternalId:  "ext",
+		FileIds:     []int64{5, 6},
+		EmbedURL:    "https://example.com",
+		AlertInvite: true,
+		Silent:      true,
+		NoHook:      true,
+	})
+	r.NoError(err)
+	r.Equal("/comment/task/2/?hook=false&silent=true", uri)
+	r.JSONEq(`{"value": "hi", "external_id": "ext", "file_ids": [5, 6], "embed_url": "https://example.com", "alert_invite": true}`, body)
+
+	r.NoError(client.UpdateComment(3, "edited", &CommentOptions{ExternalId: "ext", FileIds: []int64{}}))
+	r.Equal("PUT", method)
+	r.Equal("/comment/3", uri)
+	r.JSONEq(`{"value": "edited", "file_ids": []}`, body)
+
+	r.NoError(client.DeleteComment(3, &CommentOptions{Silent: true}))
+	r.Equal("DELETE", method)
+	r.Equal("/comment/3?silent=true", uri)
+}
+
+func TestCommentInvalidRef(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		t.Fatalf("unexpected request to %s", req.URL)
+	})
+
+	_, err := client.Comment(Ref{"itme", 1}, "hi", nil)
+	r.EqualError(err, `invalid ref itme:1: unknown type "itme"`)
+
+	_, err = client.GetComments(Ref{RefItem, 0}, nil)
+	r.EqualError(err, "invalid ref item:0: id must be positive")
+
+	_, err = client.GetOrganizationStream(0, nil)
+	r.Error(err)
+	_, err = client.GetStreamObject(Ref{})
+	r.Error(err)
+}
@@ -1,5 +1,7 @@
 package podio
 
+import "fmt"
+
 // Contact describes a Podio contact object
 type Contact struct {
 	UserId     int    `json:"user_id"`
@@ -12,4 +14,135 @@ type Contact struct {
 	Avatar     int    `json:"avatar"`
 	LastSeenOn *Time  `json:"last_seen_on"`
 	Name       string `json:"name"`
+	ExternalId string `json:"external_id"`
+
+	Mail         []string `json:"mail"`
+	Phone        []string `json:"phone"`
+	Title        []string `json:"title"`
+	Organization string   `json:"organization"`
+	About        string   `json:"about"`
+	URL          []string `json:"url"`
+	Twitter      string   `json:"twitter"`
+	Skype        string   `json:"skype"`
+	LinkedIn     string   `json:"linkedin"`
+
+	Location []string `json:"location"`
+	Address  []string `json:"address"`
+	Zip      string   `json:"zip"`
+	City     string   `json:"city"`
+	State    string   `json:"state"`
+	Country  string   `json:"country"`
+}
+
+// UserStatus is the status of the active user
+type UserStatus struct {
+	User struct {
+		Id       int64  `json:"user_id"`
+		Mail     string `json:"mail"`
+		Status   string `json:"status"`
+		Locale   string `json:"locale"`
+		TimeZone string `json:"timezone"`
+	} `json:"user"`
+	Profile            Contact `json:"profile"`
+	InboxNew           int     `json:"inbox_new"`
+	MessageUnreadCount int     `json:"message_unread_count"`
+}
+
+// GetUserStatus returns the status of the active user along with the profile.
+//
+// https://developers.podio.com/doc/users
+func (client *Client) GetUserStatus() (status *UserStatus, err error) {
+	err = client.Request("GET", "/user/status", nil, nil, &status)
+	return
+}
+
+// GetProfile returns the profile of the active user.
+//
+// https://developers.podio.com/doc/users
+func (client *Client) GetProfile() (profile *Contact, err error) {
+	err = client.Request("GET", "/user/profile/", nil, nil, &profile)
+	return
+}
+
+// GetContacts returns the contacts of the active user.
+//
+// The contacts can be filtered with the following keys in the params map:
+//
+//	name: the name of the contact, or part of it
+//	mail: the mail address of the contact
+//	type: user, space or connection
+//	exclude_self: true to leave out the active user
+//	limit, offset: paging
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) GetContacts(params map[string]interface{}) (contacts []*Contact, err error) {
+	err = client.RequestWithParams("GET", "/contact/", nil, params, &contacts)
+	return
+}
+
+// GetSpaceContacts returns the contacts on a space, filtered like GetContacts.
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) GetSpaceContacts(spaceId int64, params map[string]interface{}) (contacts []*Contact, err error) {
+	path := fmt.Sprintf("/contact/space/%d/", spaceId)
+	err = client.RequestWithParams("GET", path, nil, params, &contacts)
+	return
+}
+
+// GetOrganizationContacts returns the contacts in an organization, filtered like GetContacts.
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) GetOrganizationContacts(orgId int64, params map[string]interface{}) (contacts []*Contact, err error) {
+	path := fmt.Sprintf("/contact/org/%d/", orgId)
+	err = client.RequestWithParams("GET", path, nil, params, &contacts)
+	return
+}
+
+// GetContact returns the contact with the given profile id.
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) GetContact(profileId int64) (contact *Contact, err error) {
+	path := fmt.Sprintf("/contact/%d/v2", profileId)
+	err = client.Request("GET", path, nil, nil, &contact)
+	return
+}
+
+// CreateSpaceContact adds a contact to a space and returns its profile id.
+// The fields of the contact are given in the params map using the json names of Contact, e.g. name, mail and phone.
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) CreateSpaceContact(spaceId int64, params map[string]interface{}) (int64, error) {
+	path := fmt.Sprintf("/contact/space/%d/", spaceId)
+
+	rsp := &struct {
+		ProfileId int64 `json:"profile_id"`
+	}{}
+	err := client.RequestWithParams("POST", path, nil, params, rsp)
+
+	return rsp.ProfileId, err
+}
+
+// UpdateContact updates the fields of a space contact given in the params map.
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) UpdateContact(profileId int64, params map[string]interface{}) error {
+	path := fmt.Sprintf("/contact/%d", profileId)
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
+// DeleteContact deletes a space contact.
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) DeleteContact(profileId int64) error {
+	path := fmt.Sprintf("/contact/%d", profileId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// GetContactVCard returns the contact as a vCard.
+//
+// https://developers.podio.com/doc/contacts
+func (client *Client) GetContactVCard(profileId int64) (vcard []byte, err error) {
+	path := fmt.Sprintf("/contact/%d/vcard", profileId)
+	err = client.Request("GET", path, nil, nil, &vcard)
+	return
 }
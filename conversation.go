@@ -0,0 +1,169 @@
+package podio
+
+import "fmt"
+
+// Conversation is a private conversation between a set of participants
+type Conversation struct {
+	Id           int64                  `json:"conversation_id"`
+	Subject      string                 `json:"subject"`
+	Type         string                 `json:"type"` // direct or group
+	Ref          *Reference             `json:"ref"`
+	Participants []ByLine               `json:"participants"`
+	Messages     []*ConversationMessage `json:"messages"`
+	Excerpt      string                 `json:"excerpt"`
+	Starred      bool                   `json:"starred"`
+	Unread       bool                   `json:"unread"`
+	UnreadCount  int                    `json:"unread_count"`
+	LastEventOn  Time                   `json:"last_event_on"`
+	CreatedBy    ByLine                 `json:"created_by"`
+	CreatedOn    Time                   `json:"created_on"`
+	Push         Push                   `json:"push"`
+}
+
+// ConversationMessage is a single message in a conversation
+type ConversationMessage struct {
+	Id         int64   `json:"message_id"`
+	Text       string  `json:"text"`
+	Files      []*File `json:"files"`
+	Embed      *Embed  `json:"embed"`
+	EmbedFile  *File   `json:"embed_file"`
+	CreatedBy  ByLine  `json:"created_by"`
+	CreatedVia Via     `json:"created_via"`
+	CreatedOn  Time    `json:"created_on"`
+}
+
+// CreateConversation starts a new conversation with the given participants (user ids).
+// Files can be attached by setting file_ids in the params map, embeds with embed_id or embed_url.
+//
+// https://developers.podio.com/doc/conversations/create-conversation-v2-37301474
+func (client *Client) CreateConversation(subject, text string, participants []int64, params map[string]interface{}) (conversation *Conversation, err error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["subject"] = subject
+	params["text"] = text
+	params["participants"] = participants
+
+	err = client.RequestWithParams("POST", "/conversation/v2/", nil, params, &conversation)
+	return
+}
+
+// CreateConversationOnObject starts a new conversation about the podio object identified by ref.
+//
+// https://developers.podio.com/doc/conversations/create-conversation-on-object-22442
+func (client *Client) CreateConversationOnObject(ref Ref, subject, text string, participants []int64, params map[string]interface{}) (conversation *Conversation, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/conversation/%s/%d/", ref.Type, ref.Id)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["subject"] = subject
+	params["text"] = text
+	params["participants"] = participants
+
+	err = client.RequestWithParams("POST", path, nil, params, &conversation)
+	return
+}
+
+// https://developers.podio.com/doc/conversations/get-conversation-22369
+func (client *Client) GetConversation(conversationId int64) (conversation *Conversation, err error) {
+	path := fmt.Sprintf("/conversation/%d", conversationId)
+	err = client.Request("GET", path, nil, nil, &conversation)
+	return
+}
+
+// GetConversations returns the conversations of the active user, newest first.
+// The page is controlled with limit and offset in the params map.
+//
+// https://developers.podio.com/doc/conversations/get-conversations-34822801
+func (client *Client) GetConversations(params map[string]interface{}) (conversations []*Conversation, err error) {
+	err = client.RequestWithParams("GET", "/conversation/", nil, params, &conversations)
+	return
+}
+
+// SearchConversations returns the conversations of the active user matching text.
+//
+// https://developers.podio.com/doc/conversations/search-conversations-37308117
+func (client *Client) SearchConversations(text string, params map[string]interface{}) (conversations []*Conversation, err error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["text"] = text
+
+	err = client.RequestWithParams("GET", "/conversation/search/", nil, params, &conversations)
+	return
+}
+
+// GetConversationMessages returns the messages in a conversation, newest first.
+//
+// https://developers.podio.com/doc/conversations/get-messages-in-conversation-35440697
+func (client *Client) GetConversationMessages(conversationId int64, params map[string]interface{}) (messages []*ConversationMessage, err error) {
+	path := fmt.Sprintf("/conversation/%d/message/", conversationId)
+	err = client.RequestWithParams("GET", path, nil, params, &messages)
+	return
+}
+
+// ReplyToConversation adds a message to a conversation and returns the id of the message.
+// Files can be attached by setting file_ids in the params map.
+//
+// https://developers.podio.com/doc/conversations/reply-to-conversation-22439
+func (client *Client) ReplyToConversation(conversationId int64, text string, params map[string]interface{}) (int64, error) {
+	path := fmt.Sprintf("/conversation/%d/reply", conversationId)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["text"] = text
+
+	rsp := &struct {
+		MessageId int64 `json:"message_id"`
+	}{}
+	err := client.RequestWithParams("POST", path, nil, params, rsp)
+
+	return rsp.MessageId, err
+}
+
+// https://developers.podio.com/doc/conversations/mark-conversation-as-read-22436
+func (client *Client) MarkConversationAsRead(conversationId int64) error {
+	path := fmt.Sprintf("/conversation/%d/read", conversationId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// https://developers.podio.com/doc/conversations/mark-conversation-as-unread-22443
+func (client *Client) MarkConversationAsUnread(conversationId int64) error {
+	path := fmt.Sprintf("/conversation/%d/read", conversationId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// https://developers.podio.com/doc/conversations/star-conversation-35106944
+func (client *Client) StarConversation(conversationId int64) error {
+	path := fmt.Sprintf("/conversation/%d/star", conversationId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// https://developers.podio.com/doc/conversations/unstar-conversation-35106990
+func (client *Client) UnstarConversation(conversationId int64) error {
+	path := fmt.Sprintf("/conversation/%d/star", conversationId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// AddConversationParticipants adds users to a group conversation.
+//
+// https://developers.podio.com/doc/conversations/add-participants-v2-37282400
+func (client *Client) AddConversationParticipants(conversationId int64, participants []int64) error {
+	path := fmt.Sprintf("/conversation/%d/participant/v2/", conversationId)
+	params := map[string]interface{}{
+		"participants": participants,
+	}
+
+	return client.RequestWithParams("POST", path, nil, params, nil)
+}
+
+// LeaveConversation removes the active user from a group conversation.
+//
+// https://developers.podio.com/doc/conversations/leave-conversation-35483748
+func (client *Client) LeaveConversation(conversationId int64) error {
+	path := fmt.Sprintf("/conversation/%d/leave", conversationId)
+	return client.Request("POST", path, nil, nil, nil)
+}
@@ -0,0 +1,446 @@
+package podio
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat is the file format of an export
+type ExportFormat string
+
+const (
+	ExportCSV       ExportFormat = "csv"
+	ExportJSONLines ExportFormat = "jsonl"
+	ExportXLSX      ExportFormat = "xlsx"
+)
+
+// ExportOptions are the optional parameters of ExportItems
+type ExportOptions struct {
+	// ViewId limits the export to the items of a view
+	ViewId int64
+
+	// PageSize is the number of items fetched at a time. Defaults to 100,
+	// and is at most 500.
+	PageSize int
+}
+
+// exportColumns are the columns exported for every item, before the fields
+var exportColumns = []string{"item_id", "app_item_id", "title", "external_id", "created_on", "last_edit_on"}
+
+// ExportItems writes the items of an app to w in the given format and
+// returns the number of items written. There is a column for every active
+// field of the app, named by label. In JSON Lines the fields are instead
+// nested in a "fields" object by external id, so they cannot collide with
+// the columns exported for every item.
+//
+// Values are flattened for CSV and XLSX: money as amount and currency, dates
+// as ISO 8601 ranges, references as titles with item ids, and multiple values
+// joined by "; ". JSON Lines keeps their structure.
+func (client *Client) ExportItems(w io.Writer, appId int64, format ExportFormat, opts ExportOptions) (int, error) {
+	var out exportWriter
+	switch format {
+	case ExportCSV:
+		out = &csvExportWriter{w: csv.NewWriter(w)}
+	case ExportJSONLines:
+		out = &jsonExportWriter{enc: json.NewEncoder(w)}
+	case ExportXLSX:
+		out = &xlsxExportWriter{zip: zip.NewWriter(w)}
+	default:
+		return 0, fmt.Errorf("unknown export format %q", format)
+	}
+
+	appFields, err := client.GetAppFields(appId)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := append([]string{}, exportColumns...)
+	labels := append([]string{}, exportColumns...)
+	var fields []*AppField
+	for _, field := range appFields {
+		if field.Status == "deleted" {
+			continue
+		}
+		fields = append(fields, field)
+		keys = append(keys, field.ExternalId)
+		labels = append(labels, field.Label)
+	}
+
+	if err := out.WriteHeader(keys, labels); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	it := client.NewItemIterator(appId, opts.ViewId, opts.PageSize)
+	for it.Next() {
+		item := it.Item()
+		row := []interface{}{
+			item.Id,
+			item.AppItemId,
+			item.Title,
+			item.ExternalId,
+			exportTime(item.CreatedOn.Time),
+			exportTime(item.LastEditOn.Time),
+		}
+		for _, field := range fields {
+			row = append(row, exportValue(item.field(strconv.FormatInt(field.Id, 10))))
+		}
+
+		if err := out.WriteRow(row); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		return n, err
+	}
+
+	return n, out.Close()
+}
+
+// StartXLSXExport makes podio export the items of an app as an XLSX file in
+// the background and returns the id of the batch doing it. The items can be
+// limited with view_id or filters in the params map. Once the batch is
+// done, see WaitForBatch, the file is in its File and can be downloaded with DownloadFile.
+func (client *Client) StartXLSXExport(appId int64, params map[string]interface{}) (int64, error) {
+	path := fmt.Sprintf("/item/app/%d/export/xlsx", appId)
+
+	rsp := &struct {
+		BatchId int64 `json:"batch_id"`
+	}{}
+	err := client.RequestWithParams("POST", path, nil, params, rsp)
+
+	return rsp.BatchId, err
+}
+
+// Exported values which are not plain strings or numbers. They are
+// marshalled as objects in JSON Lines and flattened by String otherwise.
+type (
+	exportMoney struct {
+		Amount   float64 `json:"amount"`
+		Currency string  `json:"currency"`
+	}
+	exportRef struct {
+		ItemId int64  `json:"item_id"`
+		Title  string `json:"title"`
+	}
+	exportContact struct {
+		ProfileId int    `json:"profile_id"`
+		Name      string `json:"name"`
+	}
+	exportFile struct {
+		FileId int64  `json:"file_id"`
+		Name   string `json:"name"`
+		Link   string `json:"link"`
+	}
+	exportTyped struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+)
+
+func (m exportMoney) String() string   { return formatNumber(m.Amount) + " " + m.Currency }
+func (r exportRef) String() string     { return fmt.Sprintf("%s (%d)", r.Title, r.ItemId) }
+func (c exportContact) String() string { return c.Name }
+func (f exportFile) String() string    { return f.Link }
+func (t exportTyped) String() string   { return t.Value }
+
+func exportTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// exportValue returns the value of field for exporting. Fields holding a
+// single value give that value, others give a list.
+func exportValue(field *Field) interface{} {
+	if field == nil {
+		return nil
+	}
+
+	values := []interface{}{}
+	switch vs := field.Values.(type) {
+	case []TextValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+		return firstValue(values)
+	case []NumberValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+		return firstValue(values)
+	case []MoneyValue:
+		for _, v := range vs {
+			values = append(values, exportMoney{v.Value, v.Currency})
+		}
+		return firstValue(values)
+	case []ProgressValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+		return firstValue(values)
+	case []DurationValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+		return firstValue(values)
+	case []CalculationValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+		return firstValue(values)
+	case []DateValue:
+		for _, v := range vs {
+			var start, end interface{}
+			if v.Start != nil {
+				start = exportTime(v.Start.Time)
+			}
+			if v.End != nil && (v.Start == nil || !v.End.Equal(v.Start.Time)) {
+				end = exportTime(v.End.Time)
+			}
+			if end == nil {
+				values = append(values, start)
+			} else {
+				values = append(values, fmt.Sprintf("%s/%s", start, end))
+			}
+		}
+		return firstValue(values)
+	case []CategoryValue:
+		for _, v := range vs {
+			values = append(values, v.Value.Text)
+		}
+	case []AppValue:
+		for _, v := range vs {
+			values = append(values, exportRef{v.Value.Id, v.Value.Title})
+		}
+	case []ContactValue:
+		for _, v := range vs {
+			values = append(values, exportContact{v.Value.ProfileId, v.Value.Name})
+		}
+	case []ImageValue:
+		for _, v := range vs {
+			values = append(values, exportFile{v.Value.Id, v.Value.Name, v.Value.Link})
+		}
+	case []EmbedValue:
+		for _, v := range vs {
+			values = append(values, v.Embed.URL)
+		}
+	case []LocationValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []EmailValue:
+		for _, v := range vs {
+			values = append(values, exportTyped{v.Type, v.Value})
+		}
+	case []PhoneValue:
+		for _, v := range vs {
+			values = append(values, exportTyped{v.Type, v.Value})
+		}
+	case []TelValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []MemberValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []QuestionValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []VideoValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []interface{}:
+		// fields of unknown types are exported as podio returned them
+		return vs
+	}
+	return values
+}
+
+func firstValue(values []interface{}) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
+
+// flatten returns an exported value as text
+func flatten(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return formatNumber(v)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, elem := range v {
+			parts[i] = flatten(elem)
+		}
+		return strings.Join(parts, "; ")
+	case fmt.Stringer:
+		return v.String()
+	case map[string]interface{}, []map[string]interface{}:
+		buf, _ := json.Marshal(v)
+		return string(buf)
+	}
+	return fmt.Sprint(value)
+}
+
+type exportWriter interface {
+	WriteHeader(keys, labels []string) error
+	WriteRow(values []interface{}) error
+	Close() error
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (cw *csvExportWriter) WriteHeader(keys, labels []string) error {
+	return cw.w.Write(labels)
+}
+
+func (cw *csvExportWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = flatten(value)
+	}
+	return cw.w.Write(record)
+}
+
+func (cw *csvExportWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type jsonExportWriter struct {
+	enc  *json.Encoder
+	keys []string
+}
+
+func (jw *jsonExportWriter) WriteHeader(keys, labels []string) error {
+	jw.keys = keys
+	return nil
+}
+
+func (jw *jsonExportWriter) WriteRow(values []interface{}) error {
+	object := make(map[string]interface{}, len(exportColumns)+1)
+	fields := make(map[string]interface{}, len(values)-len(exportColumns))
+	for i, value := range values {
+		if i < len(exportColumns) {
+			object[jw.keys[i]] = value
+		} else {
+			fields[jw.keys[i]] = value
+		}
+	}
+	object["fields"] = fields
+	return jw.enc.Encode(object)
+}
+
+func (jw *jsonExportWriter) Close() error {
+	return nil
+}
+
+// xlsxExportWriter writes a workbook with a single sheet. Rows are streamed
+// to the sheet, which is the last part of the file.
+type xlsxExportWriter struct {
+	zip   *zip.Writer
+	sheet io.Writer
+	rows  int
+}
+
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Items" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+}
+
+func (xw *xlsxExportWriter) WriteHeader(keys, labels []string) error {
+	for _, part := range xlsxParts {
+		w, err := xw.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, part.content); err != nil {
+			return err
+		}
+	}
+
+	sheet, err := xw.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	xw.sheet = sheet
+
+	_, err = io.WriteString(sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	if err != nil {
+		return err
+	}
+
+	header := make([]interface{}, len(labels))
+	for i, label := range labels {
+		header[i] = label
+	}
+	return xw.WriteRow(header)
+}
+
+func (xw *xlsxExportWriter) WriteRow(values []interface{}) error {
+	xw.rows++
+	row := &strings.Builder{}
+	fmt.Fprintf(row, `<row r="%d">`, xw.rows)
+	for i, value := range values {
+		ref := xlsxColumn(i) + strconv.Itoa(xw.rows)
+		switch v := value.(type) {
+		case nil:
+			continue
+		case int, int64:
+			fmt.Fprintf(row, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case float64:
+			fmt.Fprintf(row, `<c r="%s"><v>%s</v></c>`, ref, formatNumber(v))
+		default:
+			fmt.Fprintf(row, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			xml.EscapeText(row, []byte(flatten(v)))
+			row.WriteString(`</t></is></c>`)
+		}
+	}
+	row.WriteString(`</row>`)
+
+	_, err := io.WriteString(xw.sheet, row.String())
+	return err
+}
+
+func (xw *xlsxExportWriter) Close() error {
+	if _, err := io.WriteString(xw.sheet, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	return xw.zip.Close()
+}
+
+// xlsxColumn returns the name of the column with index i, e.g. A, Z or AA
+func xlsxColumn(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
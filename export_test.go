@@ -0,0 +1,123 @@
+package podio
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func exportServer(t *testing.T) http.HandlerFunc {
+	// the title of the item differs from the value of its title field
+	item := bytes.Replace(getFixtureJSON(t, "fixtures/item_225607452.json"), []byte(`"title": "Title"`), []byte(`"title": "Item title"`), 1)
+	return func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/app/1":
+			w.Write([]byte(`{"app_id": 1, "fields": [
+				{"field_id": 80608093, "external_id": "title", "type": "text", "label": "Title", "status": "active"},
+				{"field_id": 1, "external_id": "old", "type": "text", "label": "Old", "status": "deleted"},
+				{"field_id": 80608147, "external_id": "category", "type": "category", "label": "Category", "status": "active"},
+				{"field_id": 80608148, "external_id": "date", "type": "date", "label": "Date", "status": "active"},
+				{"field_id": 80608150, "external_id": "contact", "type": "contact", "label": "Contact", "status": "active"},
+				{"field_id": 80608154, "external_id": "money", "type": "money", "label": "Money", "status": "active"},
+				{"field_id": 2, "external_id": "empty", "type": "number", "label": "Empty", "status": "active"}
+			]}`))
+		case "/item/app/1/filter":
+			w.Write([]byte(`{"filtered": 1, "items": [`))
+			w.Write(item)
+			w.Write([]byte(`]}`))
+		default:
+			http.NotFound(w, req)
+		}
+	}
+}
+
+func TestExportItems(t *testing.T) {
+	r := require.New(t)
+	client := newTestClient(exportServer(t))
+
+	buf := &bytes.Buffer{}
+	n, err := client.ExportItems(buf, 1, ExportCSV, ExportOptions{})
+	r.NoError(err)
+	r.Equal(1, n)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	r.Len(lines, 2)
+	r.Equal("item_id,app_item_id,title,external_id,created_on,last_edit_on,Title,Category,Date,Contact,Money,Empty", lines[0])
+	r.Contains(lines[1], ",Item title,")
+	r.Contains(lines[1], ",Title,B,2014-12-11T22:00:00Z,Brian Stengaard,541.987 EUR,")
+
+	buf.Reset()
+	_, err = client.ExportItems(buf, 1, ExportJSONLines, ExportOptions{})
+	r.NoError(err)
+	row := struct {
+		Title  string                 `json:"title"`
+		Fields map[string]interface{} `json:"fields"`
+	}{}
+	r.NoError(json.Unmarshal(buf.Bytes(), &row))
+	r.Equal("Item title", row.Title)
+	r.Equal("Title", row.Fields["title"])
+	r.Equal([]interface{}{"B"}, row.Fields["category"])
+	r.Equal(map[string]interface{}{"amount": 541.987, "currency": "EUR"}, row.Fields["money"])
+	r.Contains(row.Fields, "empty")
+	r.Nil(row.Fields["empty"])
+	r.NotContains(row.Fields, "old")
+
+	buf.Reset()
+	_, err = client.ExportItems(buf, 1, ExportXLSX, ExportOptions{})
+	r.NoError(err)
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	r.NoError(err)
+	r.Equal("xl/worksheets/sheet1.xml", zr.File[len(zr.File)-1].Name)
+	f, err := zr.File[len(zr.File)-1].Open()
+	r.NoError(err)
+	sheet, _ := ioutil.ReadAll(f)
+	r.Contains(string(sheet), `<c r="K2" t="inlineStr"><is><t xml:space="preserve">541.987 EUR</t></is></c>`)
+}
+
+func TestExportItemsPaging(t *testing.T) {
+	r := require.New(t)
+
+	// 1200 items, served in pages of at most 300, shorter than asked for
+	var requests []string
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/app/1" {
+			w.Write([]byte(`{"app_id": 1, "fields": []}`))
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		requests = append(requests, string(body))
+		params := struct {
+			Limit  int `json:"limit"`
+			Offset int `json:"offset"`
+		}{}
+		json.Unmarshal(body, &params)
+
+		items := []string{}
+		for i := params.Offset; i < params.Offset+params.Limit && i < params.Offset+300 && i < 1200; i++ {
+			items = append(items, fmt.Sprintf(`{"item_id": %d}`, i+1))
+		}
+		fmt.Fprintf(w, `{"filtered": 1200, "items": [%s]}`, strings.Join(items, ","))
+	})
+
+	n, err := client.ExportItems(ioutil.Discard, 1, ExportJSONLines, ExportOptions{PageSize: 1000})
+	r.NoError(err)
+	r.Equal(1200, n)
+	r.Len(requests, 4)
+	r.JSONEq(`{"sort_by": "created_on", "sort_desc": false, "limit": 500, "offset": 900}`, requests[3])
+}
+
+func TestXLSXColumn(t *testing.T) {
+	r := require.New(t)
+	r.Equal("A", xlsxColumn(0))
+	r.Equal("Z", xlsxColumn(25))
+	r.Equal("AA", xlsxColumn(26))
+	r.Equal("AZ", xlsxColumn(51))
+	r.Equal("BA", xlsxColumn(52))
+}
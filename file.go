@@ -2,18 +2,66 @@ package podio
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"hash"
+	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
 )
 
 type File struct {
-	Id   int64  `json:"file_id"`
-	Name string `json:"name"`
-	Link string `json:"link"`
-	Size int    `json:"size"`
-	Push Push   `json:"push"`
+	Id            int64  `json:"file_id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Mimetype      string `json:"mimetype"`
+	Link          string `json:"link"`
+	ThumbnailLink string `json:"thumbnail_link"`
+	Size          int    `json:"size"`
+	Push          Push   `json:"push"`
+
+	// HostedBy is the service hosting the file, e.g. podio, dropbox or google
+	HostedBy string `json:"hosted_by"`
+
+	// Context is the object the file is attached to
+	Context *Reference `json:"context"`
+
+	CreatedBy  *ByLine `json:"created_by"`
+	CreatedVia *Via    `json:"created_via"`
+	CreatedOn  *Time   `json:"created_on"`
+}
+
+// FileListOptions are the paging and sorting parameters of file listings
+type FileListOptions struct {
+	// AttachedTo limits the files to those attached to objects of a type, e.g. item or status
+	AttachedTo RefType
+
+	// SortBy is name or created_on, the default
+	SortBy   string
+	SortDesc bool
+
+	// Paging. Limit defaults to 50 which is also the maximum.
+	Limit  int
+	Offset int
+}
+
+func (opts FileListOptions) params() map[string]interface{} {
+	params := map[string]interface{}{}
+	if opts.AttachedTo != "" {
+		params["attached_to"] = opts.AttachedTo
+	}
+	if opts.SortBy != "" {
+		params["sort_by"] = opts.SortBy
+		params["sort_desc"] = opts.SortDesc
+	}
+	if opts.Limit > 0 {
+		params["limit"] = opts.Limit
+	}
+	if opts.Offset > 0 {
+		params["offset"] = opts.Offset
+	}
+	return params
 }
 
 // https://developers.podio.com/doc/files/get-files-4497983
@@ -28,57 +76,246 @@ func (client *Client) GetFile(fileId int) (file *File, err error) {
 	return
 }
 
-func (client *Client) GetFileContents(url string) ([]byte, error) {
-	link := fmt.Sprintf("%s?oauth_token=%s", url, client.authToken.AccessToken)
-	resp, err := http.Get(link)
+// GetAppFiles returns the files attached to an app and its items.
+func (client *Client) GetAppFiles(appId int64, opts FileListOptions) (files []*File, err error) {
+	path := fmt.Sprintf("/file/app/%d/", appId)
+	err = client.RequestWithParams("GET", path, nil, opts.params(), &files)
+	return
+}
+
+// GetSpaceFiles returns the files attached to objects on a space.
+func (client *Client) GetSpaceFiles(spaceId int64, opts FileListOptions) (files []*File, err error) {
+	path := fmt.Sprintf("/file/space/%d/", spaceId)
+	err = client.RequestWithParams("GET", path, nil, opts.params(), &files)
+	return
+}
 
+// GetItemFiles returns the files attached to an item.
+func (client *Client) GetItemFiles(itemId int64) ([]*File, error) {
+	item, err := client.GetItem(itemId)
 	if err != nil {
 		return nil, err
 	}
+	return item.Files, nil
+}
+
+// ImageSize is the size of a variant of an image file
+type ImageSize string
 
-	respBody, err := ioutil.ReadAll(resp.Body)
-	resp.Body.Close()
+const (
+	ImageSmall  ImageSize = "small"
+	ImageMedium ImageSize = "medium"
+	ImageLarge  ImageSize = "large"
+)
+
+// VariantLink returns the link to a variant of an image file in the given size.
+func (file *File) VariantLink(size ImageSize) string {
+	return file.Link + "/" + string(size)
+}
 
+// GetFileContents returns the contents of the file at url, the link of a file.
+// Error responses are returned as *HTTPError.
+func (client *Client) GetFileContents(url string) (contents []byte, err error) {
+	err = client.Request("GET", url, nil, nil, &contents)
+	return
+}
+
+// GetFileVariant returns the contents of a variant of an image file in the given size.
+func (client *Client) GetFileVariant(file *File, size ImageSize) ([]byte, error) {
+	return client.GetFileContents(file.VariantLink(size))
+}
+
+// DownloadOptions controls DownloadFile. The zero value downloads the whole file.
+type DownloadOptions struct {
+	// Offset resumes an interrupted download from this byte of the file.
+	// The bytes before it are expected to be in the writer already.
+	Offset int64
+
+	// Progress is called as the download proceeds with the number of bytes
+	// of the file written so far, including Offset, and the size of the file
+	// (-1 if unknown).
+	Progress func(written, size int64)
+
+	// Hash receives the downloaded bytes, e.g. sha256.New() to compute a
+	// checksum of the file. When resuming, only the bytes from Offset are written to it.
+	Hash hash.Hash
+}
+
+// DownloadFile streams the contents of file to w and returns the number of bytes written.
+// The download is aborted when ctx is done. opts may be nil.
+func (client *Client) DownloadFile(ctx context.Context, file *File, w io.Writer, opts *DownloadOptions) (int64, error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+
+	req, err := client.newRequest("GET", file.Link, nil, nil)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	if opts.Offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", opts.Offset))
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if opts.Offset > 0 && resp.StatusCode != http.StatusPartialContent {
+		// the range was ignored, skip the part we already have
+		if _, err := io.CopyN(ioutil.Discard, resp.Body, opts.Offset); err != nil {
+			return 0, err
+		}
+	}
+
+	size := int64(file.Size)
+	if size == 0 {
+		size = -1
+		if resp.ContentLength >= 0 {
+			size = opts.Offset + resp.ContentLength
+			if resp.StatusCode != http.StatusPartialContent {
+				size = resp.ContentLength
+			}
+		}
+	}
+
+	dst := w
+	if opts.Hash != nil {
+		dst = io.MultiWriter(dst, opts.Hash)
 	}
+	if opts.Progress != nil {
+		dst = &progressWriter{w: dst, written: opts.Offset, size: size, progress: opts.Progress}
+	}
+
+	n, err := io.Copy(dst, resp.Body)
+	if err != nil {
+		return n, err
+	}
+	if size >= 0 && opts.Offset+n != size {
+		return n, fmt.Errorf("download of file %d ended after %d of %d bytes", file.Id, opts.Offset+n, size)
+	}
+	return n, nil
+}
 
-	return respBody, nil
+// progressWriter reports the bytes written to w
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	size     int64
+	progress func(written, size int64)
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.written += int64(n)
+	pw.progress(pw.written, pw.size)
+	return n, err
 }
 
 // https://developers.podio.com/doc/files/upload-file-1004361
 func (client *Client) CreateFile(name string, contents []byte) (file *File, err error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
+	return client.UploadFile(name, bytes.NewReader(contents), int64(len(contents)))
+}
 
-	part, err := writer.CreateFormFile("source", name)
-	if err != nil {
-		return nil, err
-	}
+// UploadFile uploads size bytes read from r as a file with the given name.
+// The contents are streamed to podio without being buffered in memory.
+//
+// https://developers.podio.com/doc/files/upload-file-1004361
+func (client *Client) UploadFile(name string, r io.Reader, size int64) (file *File, err error) {
+	// The multipart envelope is built up front, so the length of the
+	// request is known and only the contents are read while sending.
+	envelope := &bytes.Buffer{}
+	writer := multipart.NewWriter(envelope)
 
-	_, err = part.Write(contents)
+	err = writer.WriteField("filename", name)
 	if err != nil {
 		return nil, err
 	}
 
-	err = writer.WriteField("filename", name)
+	_, err = writer.CreateFormFile("source", name)
 	if err != nil {
 		return nil, err
 	}
+	split := envelope.Len()
 
 	err = writer.Close()
 	if err != nil {
 		return nil, err
 	}
 
+	head, tail := envelope.Bytes()[:split], envelope.Bytes()[split:]
+	body := io.MultiReader(bytes.NewReader(head), &sizedReader{r: r, n: size}, bytes.NewReader(tail))
+
 	headers := map[string]string{
 		"Content-Type": writer.FormDataContentType(),
 	}
 
-	err = client.Request("POST", "/file", headers, body, &file)
+	req, err := client.newRequest("POST", "/file", headers, body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(envelope.Len()) + size
+
+	err = client.do(req, &file)
 	return
 }
 
+// sizedReader reads exactly n bytes from r
+type sizedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (s *sizedReader) Read(p []byte) (int, error) {
+	if s.n <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > s.n {
+		p = p[:s.n]
+	}
+
+	n, err := s.r.Read(p)
+	s.n -= int64(n)
+	if err == io.EOF && s.n > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// UploadFileFromURL makes podio fetch the file at url and returns it.
+func (client *Client) UploadFileFromURL(url string) (file *File, err error) {
+	params := map[string]interface{}{
+		"url": url,
+	}
+
+	err = client.RequestWithParams("POST", "/file/from_url/", nil, params, &file)
+	return
+}
+
+// CopyFile copies a file and returns the id of the copy, which can be attached to another object.
+func (client *Client) CopyFile(fileId int) (int64, error) {
+	path := fmt.Sprintf("/file/%d/copy", fileId)
+
+	rsp := &struct {
+		FileId int64 `json:"file_id"`
+	}{}
+	err := client.Request("POST", path, nil, nil, rsp)
+
+	return rsp.FileId, err
+}
+
+// UpdateFile updates the description of a file.
+func (client *Client) UpdateFile(fileId int, description string) error {
+	path := fmt.Sprintf("/file/%d", fileId)
+	params := map[string]interface{}{
+		"description": description,
+	}
+
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
 // https://developers.podio.com/doc/files/replace-file-22450
 func (client *Client) ReplaceFile(oldFileId, newFileId int) error {
 	path := fmt.Sprintf("/file/%d/replace", newFileId)
@@ -90,11 +327,14 @@ func (client *Client) ReplaceFile(oldFileId, newFileId int) error {
 }
 
 // https://developers.podio.com/doc/files/attach-file-22518
-func (client *Client) AttachFile(fileId int, refType string, refId int) error {
+func (client *Client) AttachFile(fileId int, ref Ref) error {
+	if err := ref.Validate(); err != nil {
+		return err
+	}
 	path := fmt.Sprintf("/file/%d/attach", fileId)
 	params := map[string]interface{}{
-		"ref_type": refType,
-		"ref_id":   refId,
+		"ref_type": ref.Type,
+		"ref_id":   ref.Id,
 	}
 
 	return client.RequestWithParams("POST", path, nil, params, nil)
@@ -0,0 +1,149 @@
+package podio
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadFile(t *testing.T) {
+	r := require.New(t)
+
+	var length int64
+	var filename, source string
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		length = req.ContentLength
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		filename = req.FormValue("filename")
+		f, _, _ := req.FormFile("source")
+		buf, _ := ioutil.ReadAll(f)
+		source = string(buf)
+		w.Write([]byte(`{"file_id": 7, "name": "notes.txt"}`))
+	})
+
+	contents := strings.Repeat("podio ", 1000)
+	file, err := client.UploadFile("notes.txt", strings.NewReader(contents), int64(len(contents)))
+	r.NoError(err)
+	r.Equal(int64(7), file.Id)
+	r.Equal("notes.txt", filename)
+	r.Equal(contents, source)
+	r.True(length > int64(len(contents)), "content length must be set")
+
+	_, err = client.UploadFile("notes.txt", strings.NewReader("short"), 10)
+	r.Error(err)
+}
+
+func TestDownloadFile(t *testing.T) {
+	r := require.New(t)
+
+	contents := []byte(strings.Repeat("0123456789", 100))
+	ranged := true
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "OAuth2 token" || req.URL.RawQuery != "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var offset int
+		if _, err := fmt.Sscanf(req.Header.Get("Range"), "bytes=%d-", &offset); err == nil && ranged {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(contents)-1, len(contents)))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(contents[offset:])
+			return
+		}
+		w.Write(contents)
+	})
+	file := &File{Id: 1, Link: "https://files.podio.com/1", Size: len(contents)}
+
+	buf := &bytes.Buffer{}
+	n, err := client.DownloadFile(context.Background(), file, buf, nil)
+	r.NoError(err)
+	r.Equal(int64(len(contents)), n)
+	r.Equal(contents, buf.Bytes())
+
+	for _, ranged = range []bool{true, false} {
+		buf := bytes.NewBuffer(contents[:400:400])
+		hash := sha256.New()
+		var written, size int64
+		opts := &DownloadOptions{
+			Offset:   400,
+			Hash:     hash,
+			Progress: func(w, s int64) { written, size = w, s },
+		}
+
+		n, err := client.DownloadFile(context.Background(), file, buf, opts)
+		r.NoError(err)
+		r.Equal(int64(600), n)
+		r.Equal(contents, buf.Bytes())
+		r.Equal(int64(len(contents)), written)
+		r.Equal(int64(len(contents)), size)
+		sum := sha256.Sum256(contents[400:])
+		r.Equal(sum[:], hash.Sum(nil))
+	}
+
+	file.Size = 2000
+	_, err = client.DownloadFile(context.Background(), file, ioutil.Discard, nil)
+	r.Error(err, "truncated downloads must fail")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.DownloadFile(ctx, file, ioutil.Discard, nil)
+	r.Error(err)
+}
+
+func TestGetFileContents(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "OAuth2 token" || req.URL.RawQuery != "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if req.URL.Path != "/1/small" {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("<html>Not found</html>"))
+			return
+		}
+		w.Write([]byte("thumbnail"))
+	})
+	file := &File{Id: 1, Link: "https://files.podio.com/1"}
+
+	contents, err := client.GetFileVariant(file, ImageSmall)
+	r.NoError(err)
+	r.Equal("thumbnail", string(contents))
+
+	_, err = client.GetFileVariant(file, ImageLarge)
+	r.IsType(&HTTPError{}, err)
+	r.Equal(http.StatusNotFound, err.(*HTTPError).StatusCode)
+
+	_, err = client.GetFileContents("http://files.podio.com/1")
+	r.Error(err, "the token must not be sent unencrypted")
+}
+
+func TestGetFileContentsOtherHost(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("contents"))
+	})
+
+	for _, link := range []string{"https://dl.dropboxusercontent.com/1", "https://podio.com.example.org/1", "https://evilpodio.com/1"} {
+		contents, err := client.GetFileContents(link)
+		r.NoError(err, link)
+		r.Equal("contents", string(contents))
+	}
+}
@@ -0,0 +1,107 @@
+package podio
+
+import "fmt"
+
+// HookType is the kind of event a hook is triggered by
+type HookType string
+
+// Hook types. Which types are valid depends on the object the hook is created on:
+// apps support item, comment, file, app, tag and form events, spaces support
+// app, task, member and status events, and app fields support item.update only.
+const (
+	HookItemCreate    HookType = "item.create"
+	HookItemUpdate    HookType = "item.update"
+	HookItemDelete    HookType = "item.delete"
+	HookCommentCreate HookType = "comment.create"
+	HookCommentDelete HookType = "comment.delete"
+	HookFileChange    HookType = "file.change"
+	HookAppUpdate     HookType = "app.update"
+	HookAppDelete     HookType = "app.delete"
+	HookAppCreate     HookType = "app.create"
+	HookTagAdd        HookType = "tag.add"
+	HookTagDelete     HookType = "tag.delete"
+	HookFormCreate    HookType = "form.create"
+	HookFormUpdate    HookType = "form.update"
+	HookFormDelete    HookType = "form.delete"
+	HookTaskCreate    HookType = "task.create"
+	HookTaskUpdate    HookType = "task.update"
+	HookTaskDelete    HookType = "task.delete"
+	HookMemberAdd     HookType = "member.add"
+	HookMemberRemove  HookType = "member.remove"
+	HookStatusCreate  HookType = "status.create"
+	HookStatusUpdate  HookType = "status.update"
+	HookStatusDelete  HookType = "status.delete"
+
+	// HookVerify is not a hook type that can be created, but the type of the
+	// event sent to a newly created hook to verify the URL.
+	HookVerify HookType = "hook.verify"
+)
+
+// Hook is a webhook which posts events on a podio object to an URL
+type Hook struct {
+	Id         int64    `json:"hook_id"`
+	Status     string   `json:"status"` // active or inactive
+	Type       HookType `json:"type"`
+	URL        string   `json:"url"`
+	CreatedBy  ByLine   `json:"created_by"`
+	CreatedVia Via      `json:"created_via"`
+	CreatedOn  Time     `json:"created_on"`
+}
+
+// CreateHook creates a hook on the app, space or app field identified by ref
+// and returns its id. The hook is inactive until it has been verified, see ValidateHook.
+//
+// https://developers.podio.com/doc/hooks/create-hook-215056
+func (client *Client) CreateHook(ref Ref, hookType HookType, url string) (int64, error) {
+	if err := ref.validateAs(RefApp, RefSpace, RefAppField); err != nil {
+		return 0, err
+	}
+	path := fmt.Sprintf("/hook/%s/%d/", ref.Type, ref.Id)
+	params := map[string]interface{}{
+		"url":  url,
+		"type": hookType,
+	}
+
+	rsp := &struct {
+		HookId int64 `json:"hook_id"`
+	}{}
+	err := client.RequestWithParams("POST", path, nil, params, rsp)
+
+	return rsp.HookId, err
+}
+
+// https://developers.podio.com/doc/hooks/get-hooks-215285
+func (client *Client) GetHooks(ref Ref) (hooks []*Hook, err error) {
+	if err = ref.validateAs(RefApp, RefSpace, RefAppField); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/hook/%s/%d/", ref.Type, ref.Id)
+	err = client.Request("GET", path, nil, nil, &hooks)
+	return
+}
+
+// https://developers.podio.com/doc/hooks/delete-hook-215291
+func (client *Client) DeleteHook(hookId int64) error {
+	path := fmt.Sprintf("/hook/%d", hookId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// RequestHookVerification makes podio send a new hook.verify event to the URL of the hook.
+//
+// https://developers.podio.com/doc/hooks/request-hook-verification-215232
+func (client *Client) RequestHookVerification(hookId int64) error {
+	path := fmt.Sprintf("/hook/%d/verify/request", hookId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// ValidateHook activates a hook using the code podio sent with the hook.verify event.
+//
+// https://developers.podio.com/doc/hooks/validate-hook-verification-215241
+func (client *Client) ValidateHook(hookId int64, code string) error {
+	path := fmt.Sprintf("/hook/%d/verify/validate", hookId)
+	params := map[string]interface{}{
+		"code": code,
+	}
+
+	return client.RequestWithParams("POST", path, nil, params, nil)
+}
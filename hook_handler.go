@@ -0,0 +1,230 @@
+package podio
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// HookEvent is an event posted by podio to the URL of a hook.
+// Only the ids relevant to the type of the event are set.
+type HookEvent struct {
+	Type   HookType
+	HookId int64
+
+	ItemId         int64
+	ItemRevisionId int64
+	ExternalId     string
+	CommentId      int64
+	FileId         int64
+	AppId          int64
+	TaskId         int64
+	StatusId       int64
+	UserId         int64
+	FormId         int64
+
+	// Code is the verification code of hook.verify events
+	Code string
+
+	// Item is the item the event is about, if the handler fetches items.
+	Item *Item
+
+	// Form is the raw form posted by podio
+	Form url.Values
+}
+
+// HookEventFunc is called with the events received by a HookHandler.
+// Returning an error makes podio deliver the event again later.
+type HookEventFunc func(event *HookEvent) error
+
+// HookHandler is an http.Handler receiving the events posted to podio hooks.
+// It answers the hook.verify handshake, ignores repeated deliveries of the
+// same event and dispatches events to the functions registered with Handle:
+//
+//	handler := podio.NewHookHandler(client)
+//	handler.Handle(podio.HookItemCreate, func(event *podio.HookEvent) error {
+//		...
+//	})
+//	http.Handle("/podio/hook", handler)
+type HookHandler struct {
+	// FetchItems makes the handler get the item of item events with GetItem
+	// and set it on the event before dispatching it. item.delete events are
+	// dispatched without the item.
+	FetchItems bool
+
+	// Events identical to one received within DedupeWindow are ignored. Defaults to 10 minutes.
+	DedupeWindow time.Duration
+
+	// ErrorLog is used to log errors from verification, fetching items and
+	// callbacks. If nil, errors are not logged.
+	ErrorLog *log.Logger
+
+	client *Client
+
+	mu       sync.Mutex
+	handlers map[HookType][]HookEventFunc
+	// delivered events by form encoding, with the time they were received
+	// or the zero time while they are being handled
+	seen map[string]time.Time
+}
+
+// NewHookHandler returns a HookHandler which uses client to validate hooks and fetch items.
+func NewHookHandler(client *Client) *HookHandler {
+	return &HookHandler{
+		DedupeWindow: 10 * time.Minute,
+		client:       client,
+		handlers:     map[HookType][]HookEventFunc{},
+		seen:         map[string]time.Time{},
+	}
+}
+
+// Handle registers fn to be called for events of the given type.
+func (h *HookHandler) Handle(hookType HookType, fn HookEventFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[hookType] = append(h.handlers[hookType], fn)
+}
+
+func (h *HookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := parseHookEvent(r.PostForm)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if event.Type == HookVerify {
+		if err := h.client.ValidateHook(event.HookId, event.Code); err != nil {
+			logf(h.ErrorLog, "podio: validating hook %d: %v", event.HookId, err)
+			http.Error(w, "validation failed", http.StatusInternalServerError)
+			return
+		}
+		return
+	}
+
+	key := r.PostForm.Encode()
+	if !h.begin(key) {
+		return
+	}
+
+	// deferred so the event is forgotten if a callback panics
+	handled := false
+	defer func() { h.finish(key, handled) }()
+
+	if err := h.dispatch(event); err != nil {
+		logf(h.ErrorLog, "podio: handling %s event of hook %d: %v", event.Type, event.HookId, err)
+		http.Error(w, "event not handled", http.StatusInternalServerError)
+		return
+	}
+	handled = true
+}
+
+func (h *HookHandler) dispatch(event *HookEvent) error {
+	h.mu.Lock()
+	handlers := h.handlers[event.Type]
+	h.mu.Unlock()
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	if h.FetchItems && event.ItemId != 0 && event.Type != HookItemDelete {
+		item, err := h.client.GetItem(event.ItemId)
+		if err != nil {
+			return fmt.Errorf("cannot get item %d: %v", event.ItemId, err)
+		}
+		event.Item = item
+	}
+
+	for _, fn := range handlers {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// begin reports whether the event with the given key should be handled,
+// marking it as in progress if so.
+func (h *HookHandler) begin(key string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	for k, received := range h.seen {
+		if !received.IsZero() && now.Sub(received) > h.DedupeWindow {
+			delete(h.seen, k)
+		}
+	}
+
+	if _, ok := h.seen[key]; ok {
+		return false
+	}
+	h.seen[key] = time.Time{}
+	return true
+}
+
+// finish marks the event as received, or forgets it if it was not handled
+// so that podio can deliver it again.
+func (h *HookHandler) finish(key string, handled bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if handled {
+		h.seen[key] = time.Now()
+	} else {
+		delete(h.seen, key)
+	}
+}
+
+func parseHookEvent(form url.Values) (*HookEvent, error) {
+	event := &HookEvent{
+		Type:       HookType(form.Get("type")),
+		ExternalId: form.Get("external_id"),
+		Code:       form.Get("code"),
+		Form:       form,
+	}
+	if event.Type == "" {
+		return nil, fmt.Errorf("missing event type")
+	}
+
+	ids := map[string]*int64{
+		"hook_id":          &event.HookId,
+		"item_id":          &event.ItemId,
+		"item_revision_id": &event.ItemRevisionId,
+		"comment_id":       &event.CommentId,
+		"file_id":          &event.FileId,
+		"app_id":           &event.AppId,
+		"task_id":          &event.TaskId,
+		"status_id":        &event.StatusId,
+		"user_id":          &event.UserId,
+		"form_id":          &event.FormId,
+	}
+	for key, id := range ids {
+		value := form.Get(key)
+		if value == "" {
+			continue
+		}
+
+		var err error
+		if *id, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s %q", key, value)
+		}
+	}
+
+	return event, nil
+}
@@ -0,0 +1,119 @@
+package podio
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func postHookEvent(h http.Handler, form url.Values) int {
+	req := httptest.NewRequest("POST", "/hook", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestHookHandlerVerify(t *testing.T) {
+	r := require.New(t)
+
+	var path, body string
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		buf, _ := ioutil.ReadAll(req.Body)
+		path, body = req.URL.Path, string(buf)
+	})
+	h := NewHookHandler(client)
+
+	code := postHookEvent(h, url.Values{"type": {"hook.verify"}, "hook_id": {"12"}, "code": {"abc"}})
+	r.Equal(http.StatusOK, code)
+	r.Equal("/hook/12/verify/validate", path)
+	r.JSONEq(`{"code": "abc"}`, body)
+}
+
+func TestHookHandlerDispatch(t *testing.T) {
+	r := require.New(t)
+
+	var gets int
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		gets++
+		r.Equal("/item/42", req.URL.Path)
+		w.Write([]byte(`{"item_id": 42, "title": "Hello"}`))
+	})
+	h := NewHookHandler(client)
+	h.FetchItems = true
+	h.ErrorLog = log.New(ioutil.Discard, "", 0)
+
+	var events []*HookEvent
+	fail := true
+	h.Handle(HookItemUpdate, func(event *HookEvent) error {
+		if fail {
+			fail = false
+			return errors.New("try again")
+		}
+		events = append(events, event)
+		return nil
+	})
+
+	form := url.Values{"type": {"item.update"}, "hook_id": {"12"}, "item_id": {"42"}, "item_revision_id": {"3"}}
+
+	// a failed event is delivered again by podio and must then be handled
+	r.Equal(http.StatusInternalServerError, postHookEvent(h, form))
+	r.Equal(http.StatusOK, postHookEvent(h, form))
+	r.Len(events, 1)
+
+	// repeated deliveries are ignored
+	r.Equal(http.StatusOK, postHookEvent(h, form))
+	r.Len(events, 1)
+	r.Equal(2, gets)
+
+	event := events[0]
+	r.Equal(HookItemUpdate, event.Type)
+	r.Equal(int64(12), event.HookId)
+	r.Equal(int64(42), event.ItemId)
+	r.Equal(int64(3), event.ItemRevisionId)
+	r.Equal("Hello", event.Item.Title)
+
+	// events without handlers are accepted but not fetched
+	r.Equal(http.StatusOK, postHookEvent(h, url.Values{"type": {"item.create"}, "item_id": {"43"}}))
+	r.Equal(2, gets)
+}
+
+func TestHookHandlerPanic(t *testing.T) {
+	r := require.New(t)
+
+	h := NewHookHandler(newTestClient(nil))
+	calls := 0
+	h.Handle(HookItemCreate, func(event *HookEvent) error {
+		if calls++; calls == 1 {
+			panic("boom")
+		}
+		return nil
+	})
+
+	form := url.Values{"type": {"item.create"}, "item_id": {"42"}}
+	r.Panics(func() { postHookEvent(h, form) })
+
+	// the event is handled when delivered again
+	r.Equal(http.StatusOK, postHookEvent(h, form))
+	r.Equal(2, calls)
+}
+
+func TestHookHandlerBadRequest(t *testing.T) {
+	r := require.New(t)
+
+	h := NewHookHandler(newTestClient(nil))
+
+	r.Equal(http.StatusBadRequest, postHookEvent(h, url.Values{"item_id": {"1"}}))
+	r.Equal(http.StatusBadRequest, postHookEvent(h, url.Values{"type": {"item.create"}, "item_id": {"x"}}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/hook", nil))
+	r.Equal(http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,140 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+)
+
+// ImportFileInfo describes the rows and columns of an uploaded CSV or XLSX file
+type ImportFileInfo struct {
+	RowCount int             `json:"row_count"`
+	Columns  []*ImportColumn `json:"columns"`
+}
+
+// ImportColumn is a column in a file to import
+type ImportColumn struct {
+	Id      string   `json:"id"`
+	Name    string   `json:"name"`
+	Samples []string `json:"samples"`
+}
+
+// ImportMapping maps columns of the file to an app field.
+// Value depends on the type of the field: most fields take the column in
+// column_id, while e.g. date fields take start and end columns.
+type ImportMapping struct {
+	FieldId int64                  `json:"field_id"`
+	Value   map[string]interface{} `json:"value"`
+
+	// Unique makes the import update existing items with the same value
+	// in the field instead of creating new ones.
+	Unique bool `json:"unique"`
+}
+
+// ColumnMapping maps a single column to an app field
+func ColumnMapping(fieldId int64, columnId string) ImportMapping {
+	return ImportMapping{
+		FieldId: fieldId,
+		Value:   map[string]interface{}{"column_id": columnId},
+	}
+}
+
+// ImportOptions are the parameters of an item import
+type ImportOptions struct {
+	Mappings []ImportMapping
+
+	// TagsColumnId is the column holding the tags of the items, if any
+	TagsColumnId string
+}
+
+func (opts ImportOptions) params() map[string]interface{} {
+	mappings := opts.Mappings
+	if mappings == nil {
+		mappings = []ImportMapping{}
+	}
+	params := map[string]interface{}{
+		"mappings": mappings,
+	}
+	if opts.TagsColumnId != "" {
+		params["tags_column_id"] = opts.TagsColumnId
+	}
+	return params
+}
+
+// ImportRowResult is the outcome of previewing a row of an import
+type ImportRowResult struct {
+	Row  int
+	Item *Item // the item as it would be imported
+	Err  error // the error podio reported for the row
+}
+
+// GetImportFileInfo returns the columns and number of rows of an uploaded file, see UploadFile.
+func (client *Client) GetImportFileInfo(fileId int64) (info *ImportFileInfo, err error) {
+	path := fmt.Sprintf("/importer/%d/info", fileId)
+	err = client.Request("GET", path, nil, nil, &info)
+	return
+}
+
+// ImportItems starts importing the rows of an uploaded CSV or XLSX file as
+// items in an app and returns the id of the batch running the import, see WaitForBatch.
+// The batch only reports how many rows were completed, skipped or failed, not
+// which or why; use PreviewImport on a sample of rows to find that out first.
+func (client *Client) ImportItems(fileId, appId int64, opts ImportOptions) (int64, error) {
+	path := fmt.Sprintf("/importer/%d/item/app/%d", fileId, appId)
+
+	rsp := &struct {
+		BatchId int64 `json:"batch_id"`
+	}{}
+	err := client.RequestWithParams("POST", path, nil, opts.params(), rsp)
+
+	return rsp.BatchId, err
+}
+
+// PreviewImportRow returns the item a row (counting from 0) of the file would be imported as.
+func (client *Client) PreviewImportRow(fileId, appId int64, row int, opts ImportOptions) (item *Item, err error) {
+	path := fmt.Sprintf("/importer/%d/item/app/%d/preview/%d", fileId, appId, row)
+	err = client.RequestWithParams("POST", path, nil, opts.params(), &item)
+	return
+}
+
+// maxPreviewRows is the most rows PreviewImport previews
+const maxPreviewRows = 100
+
+// PreviewImport previews a sample of the rows of an import (counting from 0),
+// e.g. the first few, to learn which fail and why before calling ImportItems.
+// Every row takes a request, so at most 100 rows can be previewed; it is not
+// a replacement for the import. When podio reports the rate limit is hit, the
+// preview waits as asked, or until ctx is done.
+//
+// Errors reported by podio for a row are returned in the results; any other
+// error aborts the preview.
+func (client *Client) PreviewImport(ctx context.Context, fileId, appId int64, rows []int, opts ImportOptions) ([]*ImportRowResult, error) {
+	if len(rows) == 0 || len(rows) > maxPreviewRows {
+		return nil, fmt.Errorf("preview needs 1 to %d rows, got %d", maxPreviewRows, len(rows))
+	}
+
+	results := make([]*ImportRowResult, 0, len(rows))
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		var item *Item
+		var err error
+		for {
+			item, err = client.PreviewImportRow(fileId, appId, row, opts)
+			// only rate limiting is waited out, see retryable
+			retry, wait := retryable(err)
+			if !retry || wait == 0 {
+				break
+			}
+			if err := sleep(ctx, wait); err != nil {
+				return results, err
+			}
+		}
+		if _, ok := err.(*Error); err != nil && !ok {
+			return results, err
+		}
+		results = append(results, &ImportRowResult{Row: row, Item: item, Err: err})
+	}
+	return results, nil
+}
@@ -0,0 +1,87 @@
+package podio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewImport(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		switch req.URL.Path {
+		case "/importer/5/item/app/9/preview/1":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "invalid_value", "error_description": "Title is required"}`))
+		default:
+			r.JSONEq(`{"mappings": [{"field_id": 3, "value": {"column_id": "0"}, "unique": false}]}`, string(body))
+			w.Write([]byte(`{"item_id": 0, "title": "row"}`))
+		}
+	})
+
+	opts := ImportOptions{Mappings: []ImportMapping{ColumnMapping(3, "0")}}
+	results, err := client.PreviewImport(context.Background(), 5, 9, []int{0, 1, 2}, opts)
+	r.NoError(err)
+	r.Len(results, 3)
+	r.NoError(results[0].Err)
+	r.Equal("row", results[0].Item.Title)
+	r.IsType(&Error{}, results[1].Err)
+	r.Equal(1, results[1].Row)
+	r.NoError(results[2].Err)
+
+	results, err = client.PreviewImport(context.Background(), 5, 9, []int{1}, opts)
+	r.NoError(err)
+	r.Len(results, 1)
+	r.Equal(1, results[0].Row)
+	r.IsType(&Error{}, results[0].Err)
+
+	// a sample of rows is required
+	_, err = client.PreviewImport(context.Background(), 5, 9, nil, opts)
+	r.Error(err)
+	_, err = client.PreviewImport(context.Background(), 5, 9, make([]int, maxPreviewRows+1), opts)
+	r.Error(err)
+}
+
+func TestWaitForBatch(t *testing.T) {
+	r := require.New(t)
+
+	polls := 0
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		polls++
+		if polls < 3 {
+			w.Write([]byte(`{"batch_id": 4, "status": "started", "completed": 10}`))
+			return
+		}
+		w.Write([]byte(`{"batch_id": 4, "status": "completed", "completed": 20, "failed": 1}`))
+	})
+
+	var seen []int
+	batch, err := client.WaitForBatch(context.Background(), 4, time.Millisecond, func(b *Batch) {
+		seen = append(seen, b.Completed)
+	})
+	r.NoError(err)
+	r.Equal(BatchCompleted, batch.Status)
+	r.Equal(1, batch.Failed)
+	r.Equal([]int{10, 10, 20}, seen)
+
+	polls = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	batch, err = client.WaitForBatch(ctx, 4, time.Hour, nil)
+	r.Equal(context.Canceled, err)
+	r.Equal(BatchStarted, batch.Status)
+
+	// an unset interval is defaulted, rather than polling without pause
+	polls = 0
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForBatch(ctx, 4, 0, nil)
+	r.Equal(context.DeadlineExceeded, err)
+	r.Equal(1, polls)
+}
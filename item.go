@@ -3,6 +3,7 @@ package podio
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // Item describes a Podio item object
@@ -18,6 +19,7 @@ type Item struct {
 	CreatedVia         Via      `json:"created_via"`
 	CreatedBy          ByLine   `json:"created_by"`
 	CreatedOn          Time     `json:"created_on"`
+	LastEditOn         Time     `json:"last_edit_on"`
 	Link               string   `json:"link"`
 	Revision           int      `json:"revision"`
 	Push               Push     `json:"push"`
@@ -396,6 +398,48 @@ type ItemList struct {
 	Items    []*Item `json:"items"`
 }
 
+// ItemFilter builds the filters parameter of FilterItems:
+//
+//	params := map[string]interface{}{
+//		"filters": podio.ItemFilter{}.Tags("urgent"),
+//	}
+//
+// The methods return the filter, which is allocated if f is nil, so the
+// result must be used: var f podio.ItemFilter; f = f.Tags("urgent").
+type ItemFilter map[string]interface{}
+
+// set sets a filter, allocating f if it is nil
+func (f ItemFilter) set(key string, value interface{}) ItemFilter {
+	if f == nil {
+		f = ItemFilter{}
+	}
+	f[key] = value
+	return f
+}
+
+// Tags limits the items to those with all of the given tags
+func (f ItemFilter) Tags(tags ...string) ItemFilter {
+	return f.set("tags", tags)
+}
+
+// ExternalId limits the items to those with one of the given external ids
+func (f ItemFilter) ExternalId(externalIds ...string) ItemFilter {
+	return f.set("external_id", externalIds)
+}
+
+// LastEditOn limits the items to those last edited between from and to, both
+// inclusive. A zero from or to leaves that end of the range open.
+func (f ItemFilter) LastEditOn(from, to time.Time) ItemFilter {
+	dates := map[string]string{}
+	if !from.IsZero() {
+		dates["from"] = from.UTC().Format(podioLayout)
+	}
+	if !to.IsZero() {
+		dates["to"] = to.UTC().Format(podioLayout)
+	}
+	return f.set("last_edit_on", dates)
+}
+
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) GetItems(appId int64) (items *ItemList, err error) {
 	path := fmt.Sprintf("/item/app/%d/filter?fields=items.fields(files)", appId)
@@ -410,6 +454,106 @@ func (client *Client) FilterItems(appId int64, params map[string]interface{}) (i
 	return
 }
 
+// FilterItemsByView returns the items of an app matching the filters of a view.
+func (client *Client) FilterItemsByView(appId, viewId int64, params map[string]interface{}) (items *ItemList, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter/%d/?fields=items.fields(files)", appId, viewId)
+	err = client.RequestWithParams("POST", path, nil, params, &items)
+	return
+}
+
+// maxFilterLimit is the most items podio returns for a filter request
+const maxFilterLimit = 500
+
+// ItemIterator pages through the items of an app or view one item at a time.
+// It is used like StreamIterator.
+//
+// Items are paged in the order they were created, so items edited while
+// iterating keep their place. Items created meanwhile are returned at the
+// end, while items deleted meanwhile make later items shift and be skipped.
+type ItemIterator struct {
+	client   *Client
+	appId    int64
+	viewId   int64
+	pageSize int
+	offset   int
+
+	page []*Item
+	pos  int
+	done bool
+	err  error
+}
+
+// NewItemIterator returns an iterator over the items of an app, or of a view if viewId is not 0.
+// pageSize defaults to 100 if 0, and is at most 500.
+func (client *Client) NewItemIterator(appId, viewId int64, pageSize int) *ItemIterator {
+	if pageSize <= 0 {
+		pageSize = 100
+	} else if pageSize > maxFilterLimit {
+		pageSize = maxFilterLimit
+	}
+
+	return &ItemIterator{
+		client:   client,
+		appId:    appId,
+		viewId:   viewId,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances to the next item. It returns false when there are no more
+// items or an error occurred.
+func (it *ItemIterator) Next() bool {
+	for it.pos >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+
+	it.pos++
+	return true
+}
+
+// Item returns the current item.
+func (it *ItemIterator) Item() *Item {
+	return it.page[it.pos-1]
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *ItemIterator) Err() error {
+	return it.err
+}
+
+func (it *ItemIterator) fetch() {
+	params := map[string]interface{}{
+		"sort_by":   "created_on",
+		"sort_desc": false,
+		"limit":     it.pageSize,
+		"offset":    it.offset,
+	}
+
+	var items *ItemList
+	var err error
+	if it.viewId != 0 {
+		items, err = it.client.FilterItemsByView(it.appId, it.viewId, params)
+	} else {
+		items, err = it.client.FilterItems(it.appId, params)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+	if items == nil {
+		items = &ItemList{}
+	}
+
+	it.page, it.pos = items.Items, 0
+	it.offset += len(items.Items)
+	// pages can be shorter than asked for, so only the total tells when all
+	// items have been returned
+	it.done = len(items.Items) == 0 || it.offset >= items.Filtered
+}
+
 // https://developers.podio.com/doc/items/get-item-by-app-item-id-66506688
 func (client *Client) GetItemByAppItemId(appId int64, formattedAppItemId string) (item *Item, err error) {
 	path := fmt.Sprintf("/app/%d/item/%s", appId, formattedAppItemId)
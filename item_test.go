@@ -108,14 +108,20 @@ func TestUnmarshalFieldValuesAndSettings(t *testing.T) {
 						UserId: 2468975,
 						Type:   "user",
 						Image: File{
-							Id:   125807791,
-							Link: "https://d2cmuesa4snpwn.cloudfront.net/public/125807791",
+							Id:            125807791,
+							Link:          "https://d2cmuesa4snpwn.cloudfront.net/public/125807791",
+							ThumbnailLink: "https://d2cmuesa4snpwn.cloudfront.net/public/125807791",
+							HostedBy:      "podio",
 						},
 						ProfileId:  140798621,
 						Link:       "https://podio.com/users/2468975",
 						Avatar:     125807791,
 						Name:       "Brian Stengaard",
 						LastSeenOn: parseTime(t, "2014-12-10 15:26:35"),
+						Mail:       []string{"brian@hoisthq.com", "brian@stengaard.eu"},
+						Phone:      []string{"+4529870446"},
+						URL:        []string{"http://stengaard.eu/"},
+						Twitter:    "@brianstengaard",
 					}}},
 					settings: ContactFieldSettings{"space_users", []string{"user"}},
 				},
@@ -202,6 +208,7 @@ func TestUnmarshalFieldValuesAndSettings(t *testing.T) {
 							URL:      "https://podio.com/podio/sandbox-4fcx2i",
 							URLLabel: "sandbox-4fcx2i",
 							OrgId:    736,
+							Type:     "regular",
 						},
 						App: App{
 							Id:              10421272,
@@ -272,24 +279,35 @@ func TestUnmarshalFieldValuesAndSettings(t *testing.T) {
 						SpaceId: 0,
 						Type:    "user",
 						Image: File{
-							Id:   297530466,
-							Link: "https://d2cmuesa4snpwn.cloudfront.net/public/297530466",
+							Id:            297530466,
+							Link:          "https://d2cmuesa4snpwn.cloudfront.net/public/297530466",
+							ThumbnailLink: "https://d2cmuesa4snpwn.cloudfront.net/public/297530466",
+							HostedBy:      "podio",
 						},
 						ProfileId:  140798621,
 						Link:       "https://podio.com/users/2468975",
 						Avatar:     297530466,
 						Name:       "Brian Stengaard",
 						LastSeenOn: parseTime(t, "2017-03-21 15:43:34"),
+						Mail:       []string{"brian@hoisthq.com", "brian@stengaard.eu"},
+						Phone:      []string{"9197936102"},
+						Title:      []string{"Site Reliability Engineer"},
+						URL:        []string{"http://stengaard.eu/"},
+						Twitter:    "@brianstengaard",
+						Location:   []string{"Raleigh, North Carolina"},
 					}}},
 					settings: ContactFieldSettings{"space_users", []string{"user"}},
 				},
 				{ignore: "we already have a test for Number field"},
 				{
 					values: []ImageValue{{Value: File{
-						Id:   195174666,
-						Name: "a1.jpeg",
-						Link: "https://files.podio.com/195174666",
-						Size: 45326,
+						Id:            195174666,
+						Name:          "a1.jpeg",
+						Mimetype:      "image/jpeg",
+						Link:          "https://files.podio.com/195174666",
+						ThumbnailLink: "https://files.podio.com/195174666",
+						Size:          45326,
+						HostedBy:      "podio",
 					}}},
 					settings: ImageFieldSettings{[]string{"image/png"}},
 				},
@@ -297,7 +315,7 @@ func TestUnmarshalFieldValuesAndSettings(t *testing.T) {
 				{
 					values: []EmbedValue{{
 						Embed: Embed{Id: 117018345, Type: "link", Title: "Google", Description: "Search the world's information, including webpages, images, videos and more. Google has many special features to help you find exactly what you're looking for.", EmbedHTML: "", URL: "https://google.com/", OriginalURL: "https://google.com/", ResolvedURL: "https://google.com/", Hostname: "google.com", EmbedHeight: 0, EmbedWidth: 0},
-						File:  File{Id: 231335541, Link: "https://files.podio.com/231335541"},
+						File:  File{Id: 231335541, Link: "https://files.podio.com/231335541", ThumbnailLink: "https://files.podio.com/231335541", HostedBy: "podio"},
 					}},
 				},
 			},
@@ -386,3 +404,11 @@ func getFixtureJSON(t *testing.T, name string) []byte {
 
 	return buf
 }
+
+func TestItemFilter(t *testing.T) {
+	r := require.New(t)
+
+	var f ItemFilter
+	f = f.Tags("urgent").ExternalId("a", "b")
+	r.Equal(ItemFilter{"tags": []string{"urgent"}, "external_id": []string{"a", "b"}}, f)
+}
@@ -0,0 +1,137 @@
+package podio
+
+import "fmt"
+
+// Notification is a notification to the active user about activity on a podio object
+type Notification struct {
+	Id   int64  `json:"notification_id"`
+	Type string `json:"type"` // comment, file, rating, member_reference_add, ...
+	Text string `json:"text"`
+	Icon string `json:"icon"`
+
+	// From is the user or app that caused the notification
+	From       ByLine `json:"created_by"`
+	CreatedVia Via    `json:"created_via"`
+	CreatedOn  Time   `json:"created_on"`
+
+	// ViewedOn is nil if the notification has not been viewed
+	ViewedOn *Time `json:"viewed_on"`
+	Starred  bool  `json:"starred"`
+
+	Data map[string]interface{} `json:"data"`
+
+	// Context is the object the notification is about
+	Context *NotificationContext `json:"context"`
+}
+
+// Viewed reports whether the notification has been marked as viewed
+func (n *Notification) Viewed() bool {
+	return n.ViewedOn != nil && !n.ViewedOn.IsZero()
+}
+
+// NotificationContext describes the podio object a group of notifications is about
+type NotificationContext struct {
+	Ref   *Reference             `json:"ref"`
+	Title string                 `json:"title"`
+	Link  string                 `json:"link"`
+	Space *Space                 `json:"space"`
+	Data  map[string]interface{} `json:"data"`
+}
+
+// GetNotifications returns the notifications of the active user, newest first.
+// Podio groups notifications by the object they are about; the groups are
+// flattened and Context is set on each notification.
+//
+// The notifications can be filtered with the following keys in the params map:
+//
+//	viewed: true or false
+//	type: notification type, e.g. "comment"
+//	context_type: type of the object the notification is about, e.g. "item"
+//	created_from, created_to: date range in the podio time format
+//	starred: true or false
+//	limit, offset: paging
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) GetNotifications(params map[string]interface{}) (notifications []*Notification, err error) {
+	var groups []struct {
+		Context       *NotificationContext `json:"context"`
+		Notifications []*Notification      `json:"notifications"`
+	}
+
+	err = client.RequestWithParams("GET", "/notification/", nil, params, &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		for _, notification := range group.Notifications {
+			notification.Context = group.Context
+			notifications = append(notifications, notification)
+		}
+	}
+	return
+}
+
+// GetNotification returns a single notification.
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) GetNotification(notificationId int64) (notification *Notification, err error) {
+	path := fmt.Sprintf("/notification/%d", notificationId)
+	err = client.Request("GET", path, nil, nil, &notification)
+	return
+}
+
+// GetNotificationCount returns the number of unviewed notifications of the active user.
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) GetNotificationCount() (int, error) {
+	rsp := &struct {
+		New int `json:"new"`
+	}{}
+	err := client.Request("GET", "/notification/inbox/new/count", nil, nil, rsp)
+
+	return rsp.New, err
+}
+
+// MarkNotificationAsViewed marks a single notification as viewed.
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) MarkNotificationAsViewed(notificationId int64) error {
+	path := fmt.Sprintf("/notification/%d/viewed", notificationId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// MarkAllNotificationsAsViewed marks all notifications of the active user as viewed.
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) MarkAllNotificationsAsViewed() error {
+	return client.Request("POST", "/notification/viewed", nil, nil, nil)
+}
+
+// MarkNotificationsAsViewedByRef marks all notifications about the podio object
+// identified by ref as viewed.
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) MarkNotificationsAsViewedByRef(ref Ref) error {
+	if err := ref.Validate(); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/notification/%s/%d/viewed", ref.Type, ref.Id)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// StarNotification stars a notification so it is kept at hand.
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) StarNotification(notificationId int64) error {
+	path := fmt.Sprintf("/notification/%d/star", notificationId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// UnstarNotification removes the star from a notification.
+//
+// https://developers.podio.com/doc/notifications
+func (client *Client) UnstarNotification(notificationId int64) error {
+	path := fmt.Sprintf("/notification/%d/star", notificationId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
@@ -3,9 +3,33 @@ package podio
 import "fmt"
 
 type Organization struct {
-	Id   int64  `json:"org_id"`
-	Slug string `json:"url_label"`
-	Name string `json:"name"`
+	Id        int64    `json:"org_id"`
+	Slug      string   `json:"url_label"`
+	Name      string   `json:"name"`
+	URL       string   `json:"url"`
+	Logo      int64    `json:"logo"`
+	Image     *File    `json:"image"`
+	Type      string   `json:"type"` // free, sponsored or premium
+	Tier      string   `json:"tier"`
+	Premium   bool     `json:"premium"`
+	Role      string   `json:"role"`
+	Status    string   `json:"status"`
+	Rights    []string `json:"rights"`
+	Domains   []string `json:"domains"`
+	Spaces    []Space  `json:"spaces"`
+	UserLimit int      `json:"user_limit"`
+	CreatedBy ByLine   `json:"created_by"`
+	CreatedOn Time     `json:"created_on"`
+}
+
+// OrganizationMember is the membership of a user in an organization
+type OrganizationMember struct {
+	Profile  Contact `json:"profile"`
+	Role     string  `json:"role"` // admin, regular or light
+	Employee bool    `json:"employee"`
+
+	// SpaceMemberships is the number of spaces in the organization the user is a member of
+	SpaceMemberships int `json:"space_memberships"`
 }
 
 func (client *Client) GetOrganizations() (orgs []Organization, err error) {
@@ -24,3 +48,84 @@ func (client *Client) GetOrganizationBySlug(slug string) (org *Organization, err
 	err = client.Request("GET", path, nil, nil, &org)
 	return
 }
+
+// GetSharedOrganizations returns the organizations shared between the active
+// user and another user, with Spaces holding the spaces they share.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) GetSharedOrganizations(userId int64) (orgs []Organization, err error) {
+	path := fmt.Sprintf("/org/shared/%d", userId)
+	err = client.Request("GET", path, nil, nil, &orgs)
+	return
+}
+
+// GetOrganizationApps returns the apps in an organization the active user has access to.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) GetOrganizationApps(orgId int64) (apps []App, err error) {
+	path := fmt.Sprintf("/app/org/%d/?view=micro", orgId)
+	err = client.Request("GET", path, nil, nil, &apps)
+	return
+}
+
+// GetOrganizationMembers returns the members of an organization.
+// The page is controlled with limit and offset in the params map.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) GetOrganizationMembers(orgId int64, params map[string]interface{}) (members []*OrganizationMember, err error) {
+	path := fmt.Sprintf("/org/%d/member/", orgId)
+	err = client.RequestWithParams("GET", path, nil, params, &members)
+	return
+}
+
+// SearchOrganizationMembers returns the members of an organization whose name or mail matches query.
+// The page is controlled with limit and offset in the params map.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) SearchOrganizationMembers(orgId int64, query string, params map[string]interface{}) (members []*OrganizationMember, err error) {
+	path := fmt.Sprintf("/org/%d/member/search/", orgId)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["query"] = query
+
+	err = client.RequestWithParams("GET", path, nil, params, &members)
+	return
+}
+
+// GetOrganizationMember returns the membership of a user in an organization.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) GetOrganizationMember(orgId, userId int64) (member *OrganizationMember, err error) {
+	path := fmt.Sprintf("/org/%d/member/%d", orgId, userId)
+	err = client.Request("GET", path, nil, nil, &member)
+	return
+}
+
+// EndOrganizationMembership removes a user from an organization and all spaces in it.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) EndOrganizationMembership(orgId, userId int64) error {
+	path := fmt.Sprintf("/org/%d/member/%d", orgId, userId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// AddOrganizationAdmin makes a member of an organization an admin.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) AddOrganizationAdmin(orgId, userId int64) error {
+	path := fmt.Sprintf("/org/%d/admin/", orgId)
+	params := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	return client.RequestWithParams("POST", path, nil, params, nil)
+}
+
+// RemoveOrganizationAdmin makes an admin of an organization a regular member.
+//
+// https://developers.podio.com/doc/organizations
+func (client *Client) RemoveOrganizationAdmin(orgId, userId int64) error {
+	path := fmt.Sprintf("/org/%d/admin/%d", orgId, userId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
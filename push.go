@@ -12,6 +12,10 @@ type Push struct {
 }
 
 func (p *Push) Subscribe(c *bayeux.Client, out chan<- *bayeux.Message) error {
+	return p.subscribe(c, out)
+}
+
+func (p *Push) subscribe(c pushConn, out chan<- *bayeux.Message) error {
 	return c.SubscribeExt(p.Channel, out, map[string]interface{}{
 		"private_pub_signature": p.Signature,
 		"private_pub_timestamp": &p.Timestamp,
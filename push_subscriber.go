@@ -0,0 +1,491 @@
+package podio
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/andreas/go-bayeux-client"
+)
+
+// PushURL is the URL of the podio push service
+const PushURL = "https://push.podio.com/faye"
+
+// PushEventType is the kind of a push event
+type PushEventType string
+
+// Push event types
+const (
+	PushUpdate        PushEventType = "update"
+	PushDelete        PushEventType = "delete"
+	PushCommentCreate PushEventType = "comment_create"
+	PushCommentUpdate PushEventType = "comment_update"
+	PushCommentDelete PushEventType = "comment_delete"
+	PushFileCreate    PushEventType = "file_create"
+	PushFileDelete    PushEventType = "file_delete"
+	PushRatingCreate  PushEventType = "rating_like_create"
+	PushRatingDelete  PushEventType = "rating_like_delete"
+	PushTyping        PushEventType = "typing"
+	PushViewing       PushEventType = "viewing"
+	PushLeaving       PushEventType = "leaving"
+)
+
+// PushEvent is a message received on a push channel. Type tells the kind of
+// event, Ref the object it happened on and CreatedBy who did it, e.g. who is
+// typing or viewing for presence events. Comment events carry the comment,
+// see Comment; the data of other events is left as is, as its shape varies
+// by event and object type, and can be read with Decode.
+type PushEvent struct {
+	Channel    string          `json:"-"`
+	Type       PushEventType   `json:"event"`
+	Ref        *Reference      `json:"ref"`
+	CreatedBy  ByLine          `json:"created_by"`
+	CreatedVia Via             `json:"created_via"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Decode unmarshals the event specific data into v
+func (e *PushEvent) Decode(v interface{}) error {
+	return json.Unmarshal(e.Data, v)
+}
+
+// Comment returns the comment of comment events
+func (e *PushEvent) Comment() (*Comment, error) {
+	switch e.Type {
+	case PushCommentCreate, PushCommentUpdate, PushCommentDelete:
+	default:
+		return nil, fmt.Errorf("%s event has no comment", e.Type)
+	}
+
+	comment := &Comment{}
+	err := e.Decode(comment)
+	return comment, err
+}
+
+func decodePushMessage(msg *bayeux.Message) (*PushEvent, error) {
+	event := &PushEvent{}
+	if err := json.Unmarshal(msg.Data, event); err != nil {
+		return nil, fmt.Errorf("cannot decode push message on %s: %v", msg.Channel, err)
+	}
+	event.Channel = msg.Channel
+	return event, nil
+}
+
+// PushSubscriber owns a connection to the podio push service and delivers
+// the events of its subscriptions on the Events channel. It renews
+// subscriptions before their signature expires by fetching the object again,
+// and reconnects and resubscribes if the connection is lost.
+//
+//	sub := podio.NewPushSubscriber(client, func() (*bayeux.Client, error) {
+//		return bayeux.NewClient(podio.PushURL, nil)
+//	})
+//	defer sub.Close()
+//	sub.SubscribeItem(itemId)
+//	for event := range sub.Events() {
+//		...
+//	}
+type PushSubscriber struct {
+	// ErrorLog is used to log errors which the subscriber recovers from by
+	// retrying. If nil, errors are not logged.
+	ErrorLog *log.Logger
+
+	client *Client
+	dial   func() (pushConn, error)
+	events chan *PushEvent
+
+	// mu guards conn, lost and subs. Network calls are made without it.
+	mu   sync.Mutex
+	conn pushConn
+	lost bool // whether conn was lost and must be replaced
+	subs []*pushSubscription
+
+	wake      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// pushConn is the part of *bayeux.Client used by PushSubscriber
+type pushConn interface {
+	SubscribeExt(subscription string, out chan<- *bayeux.Message, ext map[string]interface{}) error
+	Unsubscribe(subscription string) error
+	Close() error
+}
+
+type pushSubscription struct {
+	fetch   func() (*Push, error)
+	conn    pushConn // the connection subscribed on, nil if not subscribed
+	push    *Push
+	in      chan *bayeux.Message
+	stop    chan struct{}
+	renewAt time.Time
+}
+
+const (
+	pushRetryDelay    = 30 * time.Second
+	pushMaxRetryDelay = 10 * time.Minute
+
+	// pushMinRenewDelay is the least time between renewals of a subscription
+	pushMinRenewDelay = 30 * time.Second
+)
+
+var errPushSubscriberClosed = fmt.Errorf("push subscriber is closed")
+
+// NewPushSubscriber returns a PushSubscriber connecting to podio push with dial.
+// client is used to fetch objects for renewing subscriptions.
+func NewPushSubscriber(client *Client, dial func() (*bayeux.Client, error)) *PushSubscriber {
+	s := newPushSubscriber(client, func() (pushConn, error) {
+		conn, err := dial()
+		if err != nil {
+			return nil, err
+		}
+		return conn, nil
+	})
+	s.start()
+	return s
+}
+
+func newPushSubscriber(client *Client, dial func() (pushConn, error)) *PushSubscriber {
+	return &PushSubscriber{
+		client: client,
+		dial:   dial,
+		events: make(chan *PushEvent, 16),
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+}
+
+// start starts renewing and resubscribing in the background
+func (s *PushSubscriber) start() {
+	s.wg.Add(1)
+	go s.run()
+}
+
+// Events returns the channel the events are delivered on. It is closed by Close.
+func (s *PushSubscriber) Events() <-chan *PushEvent {
+	return s.events
+}
+
+// Subscribe subscribes to the push channel returned by fetch, which is also
+// called to get a new signature when the subscription is about to expire.
+// It fails once the subscriber is closed.
+func (s *PushSubscriber) Subscribe(fetch func() (*Push, error)) error {
+	if s.closed() {
+		return errPushSubscriberClosed
+	}
+
+	conn, err := s.connect()
+	if err != nil {
+		return err
+	}
+
+	sub := &pushSubscription{fetch: fetch}
+	push, in, err := sub.open(conn)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed() {
+		return errPushSubscriberClosed
+	}
+
+	s.subs = append(s.subs, sub)
+	if conn == s.conn {
+		s.install(sub, conn, push, in)
+	}
+	// otherwise the connection was replaced meanwhile and run subscribes again
+	s.notify()
+	return nil
+}
+
+// SubscribeItem subscribes to the events on an item.
+func (s *PushSubscriber) SubscribeItem(itemId int64) error {
+	return s.Subscribe(func() (*Push, error) {
+		item, err := s.client.GetItem(itemId)
+		if err != nil {
+			return nil, err
+		}
+		return &item.Push, nil
+	})
+}
+
+// SubscribeSpace subscribes to the events on a space.
+func (s *PushSubscriber) SubscribeSpace(spaceId int64) error {
+	return s.Subscribe(func() (*Push, error) {
+		space, err := s.client.GetSpace(spaceId)
+		if err != nil {
+			return nil, err
+		}
+		return &space.Push, nil
+	})
+}
+
+// Close closes the connection and the Events channel. Calling it again does nothing.
+func (s *PushSubscriber) Close() (err error) {
+	s.closeOnce.Do(func() {
+		// done is closed with mu held, so no subscription is installed after
+		s.mu.Lock()
+		close(s.done)
+		s.mu.Unlock()
+
+		s.wg.Wait()
+		close(s.events)
+
+		s.mu.Lock()
+		conn := s.conn
+		s.conn = nil
+		s.mu.Unlock()
+		if conn != nil {
+			err = conn.Close()
+		}
+	})
+	return err
+}
+
+func (s *PushSubscriber) closed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// connect returns the current connection, dialing one if there is none.
+func (s *PushSubscriber) connect() (pushConn, error) {
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+	if conn != nil {
+		return conn, nil
+	}
+
+	conn, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	current, closed := s.conn, s.closed()
+	if current == nil && !closed {
+		s.conn = conn
+	}
+	s.mu.Unlock()
+
+	switch {
+	case closed:
+		conn.Close()
+		return nil, errPushSubscriberClosed
+	case current != nil:
+		// dialed concurrently, keep the other connection
+		conn.Close()
+		return current, nil
+	}
+	return conn, nil
+}
+
+// open fetches a fresh signature for sub and subscribes with it on conn
+func (sub *pushSubscription) open(conn pushConn) (*Push, chan *bayeux.Message, error) {
+	push, err := sub.fetch()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	in := make(chan *bayeux.Message, 16)
+	if err := push.subscribe(conn, in); err != nil {
+		return nil, nil, err
+	}
+	return push, in, nil
+}
+
+// install makes sub deliver the messages received on in, and schedules its
+// renewal. s.mu must be held and s must not be closed.
+func (s *PushSubscriber) install(sub *pushSubscription, conn pushConn, push *Push, in chan *bayeux.Message) {
+	s.detach(sub)
+	stop := make(chan struct{})
+	sub.conn, sub.push, sub.in, sub.stop = conn, push, in, stop
+
+	sub.renewAt = time.Time{}
+	if push.ExpiresIn > 0 {
+		// renew when 90% of the lifetime has passed, but not right away if
+		// the timestamp is stale or the clocks are skewed
+		lifetime := time.Duration(push.ExpiresIn) * time.Second
+		sub.renewAt = push.Timestamp.Add(lifetime - lifetime/10)
+		if earliest := time.Now().Add(pushMinRenewDelay); sub.renewAt.Before(earliest) {
+			sub.renewAt = earliest
+		}
+	}
+
+	s.wg.Add(1)
+	go s.forward(sub, in, stop)
+}
+
+// detach stops delivering the messages of sub. s.mu must be held.
+func (s *PushSubscriber) detach(sub *pushSubscription) {
+	if sub.stop != nil {
+		close(sub.stop)
+	}
+	sub.conn, sub.in, sub.stop = nil, nil, nil
+}
+
+// forward delivers the messages received on in until the subscription is
+// replaced or in is closed, which happens if the connection is lost.
+func (s *PushSubscriber) forward(sub *pushSubscription, in chan *bayeux.Message, stop chan struct{}) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-stop:
+			return
+		case msg, ok := <-in:
+			if !ok {
+				s.mu.Lock()
+				if sub.in == in {
+					s.lost = true
+				}
+				s.mu.Unlock()
+				s.notify()
+				return
+			}
+
+			event, err := decodePushMessage(msg)
+			if err != nil {
+				logf(s.ErrorLog, "podio: %v", err)
+				continue
+			}
+
+			select {
+			case s.events <- event:
+			case <-stop:
+				return
+			case <-s.done:
+				return
+			}
+		}
+	}
+}
+
+func (s *PushSubscriber) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *PushSubscriber) run() {
+	defer s.wg.Done()
+
+	retryDelay := pushRetryDelay
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.wake:
+		case <-timer.C:
+		}
+
+		next, err := s.renew()
+		if err != nil {
+			logf(s.ErrorLog, "podio: renewing push subscriptions: %v", err)
+			next = time.Now().Add(retryDelay)
+			if retryDelay *= 2; retryDelay > pushMaxRetryDelay {
+				retryDelay = pushMaxRetryDelay
+			}
+		} else {
+			retryDelay = pushRetryDelay
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if !next.IsZero() {
+			timer.Reset(time.Until(next))
+		}
+	}
+}
+
+// renew resubscribes the subscriptions which are about to expire, or all of
+// them on a new connection if the connection was lost. It returns the time
+// the next subscription expires.
+func (s *PushSubscriber) renew() (next time.Time, err error) {
+	s.mu.Lock()
+	reconnect := (s.lost || s.conn == nil) && len(s.subs) > 0
+	var old pushConn
+	if reconnect {
+		old, s.conn, s.lost = s.conn, nil, false
+	}
+	s.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	if reconnect {
+		_, err := s.connect()
+		if err == errPushSubscriberClosed {
+			return next, nil
+		} else if err != nil {
+			return next, err
+		}
+	}
+
+	s.mu.Lock()
+	conn := s.conn
+	now := time.Now()
+	var due []*pushSubscription
+	for _, sub := range s.subs {
+		if sub.conn != conn || (!sub.renewAt.IsZero() && !sub.renewAt.After(now)) {
+			due = append(due, sub)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, sub := range due {
+		s.mu.Lock()
+		renewing := sub.conn == conn && sub.push != nil
+		channel := ""
+		if renewing {
+			channel = sub.push.Channel
+		}
+		s.detach(sub)
+		s.mu.Unlock()
+
+		if renewing {
+			// the new signature must be used on a fresh subscription
+			conn.Unsubscribe(channel)
+		}
+
+		push, in, err := sub.open(conn)
+		if err != nil {
+			return next, err
+		}
+
+		s.mu.Lock()
+		if s.closed() || s.conn != conn {
+			s.mu.Unlock()
+			return next, nil
+		}
+		s.install(sub, conn, push, in)
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range s.subs {
+		if !sub.renewAt.IsZero() && (next.IsZero() || sub.renewAt.Before(next)) {
+			next = sub.renewAt
+		}
+	}
+	return next, nil
+}
@@ -0,0 +1,192 @@
+package podio
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andreas/go-bayeux-client"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePushConn records the subscriptions made on it
+type fakePushConn struct {
+	mu     sync.Mutex
+	outs   map[string]chan<- *bayeux.Message
+	exts   map[string]map[string]interface{}
+	unsubs []string
+	closed bool
+}
+
+func newFakePushConn() *fakePushConn {
+	return &fakePushConn{outs: map[string]chan<- *bayeux.Message{}, exts: map[string]map[string]interface{}{}}
+}
+
+func (c *fakePushConn) SubscribeExt(subscription string, out chan<- *bayeux.Message, ext map[string]interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.outs[subscription] = out
+	c.exts[subscription] = ext
+	return nil
+}
+
+func (c *fakePushConn) Unsubscribe(subscription string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsubs = append(c.unsubs, subscription)
+	return nil
+}
+
+func (c *fakePushConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *fakePushConn) out(subscription string) chan<- *bayeux.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.outs[subscription]
+}
+
+func TestPushSubscriberRenewal(t *testing.T) {
+	r := require.New(t)
+
+	conn := newFakePushConn()
+	s := newPushSubscriber(nil, func() (pushConn, error) { return conn, nil })
+	defer s.Close()
+
+	now := time.Now().Truncate(time.Second)
+	fetches := 0
+	err := s.Subscribe(func() (*Push, error) {
+		fetches++
+		return &Push{Channel: "/item/1", Signature: fmt.Sprint("sig", fetches), Timestamp: Timestamp{now}, ExpiresIn: 100}, nil
+	})
+	r.NoError(err)
+
+	// renewed when 90% of the lifetime has passed
+	next, err := s.renew()
+	r.NoError(err)
+	r.True(now.Add(90*time.Second).Equal(next), next)
+	r.Equal(1, fetches)
+
+	s.subs[0].renewAt = time.Now().Add(-time.Second)
+	_, err = s.renew()
+	r.NoError(err)
+	r.Equal(2, fetches)
+	r.Equal([]string{"/item/1"}, conn.unsubs)
+	r.Equal("sig2", conn.exts["/item/1"]["private_pub_signature"])
+	r.False(conn.closed)
+}
+
+func TestPushSubscriberStaleTimestamp(t *testing.T) {
+	r := require.New(t)
+
+	conn := newFakePushConn()
+	s := newPushSubscriber(nil, func() (pushConn, error) { return conn, nil })
+	defer s.Close()
+
+	fetches := 0
+	err := s.Subscribe(func() (*Push, error) {
+		fetches++
+		return &Push{Channel: "/item/1", Timestamp: Timestamp{time.Now().Add(-time.Hour)}, ExpiresIn: 100}, nil
+	})
+	r.NoError(err)
+
+	// the signature looks expired, but is not renewed again right away
+	next, err := s.renew()
+	r.NoError(err)
+	r.WithinDuration(time.Now().Add(pushMinRenewDelay), next, time.Second)
+	r.Equal(1, fetches)
+}
+
+func TestPushSubscriberClosed(t *testing.T) {
+	r := require.New(t)
+
+	dials := 0
+	s := newPushSubscriber(nil, func() (pushConn, error) {
+		dials++
+		return newFakePushConn(), nil
+	})
+	r.NoError(s.Close())
+
+	err := s.Subscribe(func() (*Push, error) {
+		return &Push{Channel: "/item/1"}, nil
+	})
+	r.Equal(errPushSubscriberClosed, err)
+	r.Zero(dials)
+}
+
+func TestPushSubscriberResubscribe(t *testing.T) {
+	r := require.New(t)
+
+	var mu sync.Mutex
+	var conns []*fakePushConn
+	s := newPushSubscriber(nil, func() (pushConn, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		conn := newFakePushConn()
+		conns = append(conns, conn)
+		return conn, nil
+	})
+	s.start()
+
+	err := s.Subscribe(func() (*Push, error) {
+		return &Push{Channel: "/item/1", Signature: "sig"}, nil
+	})
+	r.NoError(err)
+
+	// the connection is lost, closing the channel of the subscription
+	mu.Lock()
+	first := conns[0]
+	mu.Unlock()
+	close(first.out("/item/1"))
+
+	var second *fakePushConn
+	r.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(conns) < 2 || conns[1].out("/item/1") == nil {
+			return false
+		}
+		second = conns[1]
+		return true
+	}, time.Second, time.Millisecond)
+	first.mu.Lock()
+	r.True(first.closed)
+	first.mu.Unlock()
+
+	second.out("/item/1") <- &bayeux.Message{Channel: "/item/1", Data: []byte(`{"event": "update"}`)}
+	select {
+	case event := <-s.Events():
+		r.Equal(PushUpdate, event.Type)
+		r.Equal("/item/1", event.Channel)
+	case <-time.After(time.Second):
+		r.Fail("no event after resubscribing")
+	}
+
+	r.NoError(s.Close())
+	r.NoError(s.Close())
+}
+
+func TestDecodePushMessage(t *testing.T) {
+	r := require.New(t)
+
+	event, err := decodePushMessage(&bayeux.Message{
+		Channel: "/item/1",
+		Data:    []byte(`{"event": "comment_create", "ref": {"type": "item", "id": 1}, "data": {"comment_id": 5, "value": "hi"}}`),
+	})
+	r.NoError(err)
+	r.Equal("/item/1", event.Channel)
+	r.Equal(PushCommentCreate, event.Type)
+	r.Equal(1, event.Ref.Id)
+
+	comment, err := event.Comment()
+	r.NoError(err)
+	r.Equal("hi", comment.Value)
+
+	_, err = decodePushMessage(&bayeux.Message{Channel: "/item/1", Data: []byte(`[`)})
+	r.Error(err)
+}
@@ -0,0 +1,165 @@
+package podio
+
+import "fmt"
+
+// RatingType is a kind of rating which can be given to podio objects
+type RatingType string
+
+// Rating types and their values
+const (
+	// 0 approves, 1 disapproves
+	RatingApproved RatingType = "approved"
+	// 0 attends, 1 does not attend, 2 maybe attends
+	RatingRSVP RatingType = "rsvp"
+	// 1 to 5 stars
+	RatingFiveStar RatingType = "fivestar"
+	// 0 is yes, 1 is no
+	RatingYesNo RatingType = "yesno"
+	// 0 is thumbs up, 1 is thumbs down
+	RatingThumbs RatingType = "thumbs"
+	// 1 likes
+	RatingLike RatingType = "like"
+)
+
+// RatingSummary is the ratings of one type given to a podio object
+type RatingSummary struct {
+	Average float64 `json:"average"`
+	// Counts is the number of ratings by rating value
+	Counts map[int]RatingCount `json:"counts"`
+}
+
+// RatingCount is the number of ratings with a given value and who gave them
+type RatingCount struct {
+	Total int      `json:"total"`
+	Users []ByLine `json:"users"`
+}
+
+// Rate rates the podio object identified by ref, replacing any
+// previous rating of the same type by the active user. It returns the id of the rating.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) Rate(ref Ref, ratingType RatingType, value int) (int64, error) {
+	if err := ref.Validate(); err != nil {
+		return 0, err
+	}
+	path := fmt.Sprintf("/rating/%s/%d/%s", ref.Type, ref.Id, ratingType)
+	params := map[string]interface{}{
+		"value": value,
+	}
+
+	rsp := &struct {
+		RatingId int64 `json:"rating_id"`
+	}{}
+	err := client.RequestWithParams("POST", path, nil, params, rsp)
+
+	return rsp.RatingId, err
+}
+
+// RemoveRating removes the rating of the given type by the active user.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) RemoveRating(ref Ref, ratingType RatingType) error {
+	if err := ref.Validate(); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/rating/%s/%d/%s", ref.Type, ref.Id, ratingType)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// Like likes the podio object identified by ref and returns the new number of likes.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) Like(ref Ref) (int, error) {
+	if err := ref.Validate(); err != nil {
+		return 0, err
+	}
+	path := fmt.Sprintf("/rating/%s/%d/like", ref.Type, ref.Id)
+	params := map[string]interface{}{
+		"value": 1,
+	}
+
+	rsp := &struct {
+		LikeCount int `json:"like_count"`
+	}{}
+	err := client.RequestWithParams("POST", path, nil, params, rsp)
+
+	return rsp.LikeCount, err
+}
+
+// Unlike removes the like of the active user from the podio object identified by ref.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) Unlike(ref Ref) error {
+	return client.RemoveRating(ref, RatingLike)
+}
+
+// GetLikedBy returns the users who like the podio object identified by ref.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) GetLikedBy(ref Ref) (users []ByLine, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/rating/%s/%d/liked_by/", ref.Type, ref.Id)
+	err = client.Request("GET", path, nil, nil, &users)
+	return
+}
+
+// GetRatings returns the ratings of every type given to the podio object identified by ref.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) GetRatings(ref Ref) (ratings map[RatingType]*RatingSummary, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/rating/%s/%d", ref.Type, ref.Id)
+	err = client.Request("GET", path, nil, nil, &ratings)
+	return
+}
+
+// GetRating returns the ratings of a type given to the podio object identified by ref.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) GetRating(ref Ref, ratingType RatingType) (rating *RatingSummary, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/rating/%s/%d/%s", ref.Type, ref.Id, ratingType)
+	err = client.Request("GET", path, nil, nil, &rating)
+	return
+}
+
+// GetUserRating returns the value of the rating a user gave to the podio object identified by ref.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) GetUserRating(ref Ref, ratingType RatingType, userId int64) (int, error) {
+	if err := ref.Validate(); err != nil {
+		return 0, err
+	}
+	path := fmt.Sprintf("/rating/%s/%d/%s/%d", ref.Type, ref.Id, ratingType, userId)
+	rsp := &struct {
+		Value int `json:"value"`
+	}{}
+	err := client.Request("GET", path, nil, nil, rsp)
+
+	return rsp.Value, err
+}
+
+// VotingResult is the number of votes for one answer of a voting on an item
+type VotingResult struct {
+	Answer struct {
+		Id   int64  `json:"answer_id"`
+		Text string `json:"text"`
+	} `json:"answer"`
+	Count int      `json:"count"`
+	Users []ByLine `json:"users"`
+}
+
+// GetVotingResult returns the results of a voting on an item of a voting enabled app.
+//
+// https://developers.podio.com/doc/ratings
+func (client *Client) GetVotingResult(itemId, votingId int64) (results []*VotingResult, err error) {
+	path := fmt.Sprintf("/voting/item/%d/voting/%d/result", itemId, votingId)
+	err = client.Request("GET", path, nil, nil, &results)
+	return
+}
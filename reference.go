@@ -1,9 +1,75 @@
 package podio
 
+import "fmt"
+
+// RefType is the type of a podio object referenced from another object or by the API
+type RefType string
+
+// Reference types
+const (
+	RefAction         RefType = "action"
+	RefAlert          RefType = "alert"
+	RefApp            RefType = "app"
+	RefAppField       RefType = "app_field"
+	RefAppRevision    RefType = "app_revision"
+	RefBatch          RefType = "batch"
+	RefComment        RefType = "comment"
+	RefContact        RefType = "contact"
+	RefConversation   RefType = "conversation"
+	RefExternalFile   RefType = "external_file"
+	RefFile           RefType = "file"
+	RefForm           RefType = "form"
+	RefGrant          RefType = "grant"
+	RefHook           RefType = "hook"
+	RefItem           RefType = "item"
+	RefItemRevision   RefType = "item_revision"
+	RefLabel          RefType = "label"
+	RefLinkedAccount  RefType = "linked_account"
+	RefMessage        RefType = "message"
+	RefNotification   RefType = "notification"
+	RefOrg            RefType = "org"
+	RefProfile        RefType = "profile"
+	RefQuestion       RefType = "question"
+	RefQuestionAnswer RefType = "question_answer"
+	RefRating         RefType = "rating"
+	RefRecurrence     RefType = "recurrence"
+	RefReminder       RefType = "reminder"
+	RefSpace          RefType = "space"
+	RefSpaceMember    RefType = "space_member"
+	RefStatus         RefType = "status"
+	RefSubscription   RefType = "subscription"
+	RefTag            RefType = "tag"
+	RefTask           RefType = "task"
+	RefTaskAction     RefType = "task_action"
+	RefUser           RefType = "user"
+	RefView           RefType = "view"
+	RefVote           RefType = "vote"
+	RefVoting         RefType = "voting"
+	RefWidget         RefType = "widget"
+)
+
+var refTypes = map[RefType]bool{
+	RefAction: true, RefAlert: true, RefApp: true, RefAppField: true,
+	RefAppRevision: true, RefBatch: true, RefComment: true, RefContact: true,
+	RefConversation: true, RefExternalFile: true, RefFile: true, RefForm: true,
+	RefGrant: true, RefHook: true, RefItem: true, RefItemRevision: true,
+	RefLabel: true, RefLinkedAccount: true, RefMessage: true, RefNotification: true,
+	RefOrg: true, RefProfile: true, RefQuestion: true, RefQuestionAnswer: true,
+	RefRating: true, RefRecurrence: true, RefReminder: true, RefSpace: true,
+	RefSpaceMember: true, RefStatus: true, RefSubscription: true, RefTag: true,
+	RefTask: true, RefTaskAction: true, RefUser: true, RefView: true,
+	RefVote: true, RefVoting: true, RefWidget: true,
+}
+
+// Valid reports whether t is a known reference type
+func (t RefType) Valid() bool {
+	return refTypes[t]
+}
+
 // Reference is a reference to from one object to another Podio object
 type Reference struct {
 	Id       int                    `json:"id"`
-	Type     string                 `json:"type"`
+	Type     RefType                `json:"type"`
 	TypeName string                 `json:"type_name"`
 	Title    string                 `json:"title"`
 	Link     string                 `json:"link"`
@@ -13,3 +79,50 @@ type Reference struct {
 	CreatedBy  ByLine `json:"created_by"`
 	CreatedVia Via    `json:"created_via"`
 }
+
+// Ref returns the Ref identifying the referenced object
+func (r *Reference) Ref() Ref {
+	return Ref{Type: r.Type, Id: int64(r.Id)}
+}
+
+// Ref identifies a podio object, e.g. the object a comment is added to:
+//
+//	client.Comment(podio.NewRef(podio.RefItem, itemId), "Hello", nil)
+type Ref struct {
+	Type RefType
+	Id   int64
+}
+
+// NewRef returns the Ref identifying the podio object of the given type and id
+func NewRef(refType RefType, id int64) Ref {
+	return Ref{Type: refType, Id: id}
+}
+
+// Validate returns an error if the type of r is unknown or the id is not set.
+// It is called before requests are sent to podio.
+func (r Ref) Validate() error {
+	if !r.Type.Valid() {
+		return fmt.Errorf("invalid ref %s: unknown type %q", r, r.Type)
+	}
+	if r.Id <= 0 {
+		return fmt.Errorf("invalid ref %s: id must be positive", r)
+	}
+	return nil
+}
+
+// validateAs is like Validate, but also requires the type to be one of types
+func (r Ref) validateAs(types ...RefType) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	for _, t := range types {
+		if r.Type == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid ref %s: type must be one of %v", r, types)
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s:%d", r.Type, r.Id)
+}
@@ -0,0 +1,198 @@
+package podio
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SearchResult is a podio object matching a search
+type SearchResult struct {
+	Id           int64         `json:"id"`
+	Type         RefType       `json:"type"`
+	Title        string        `json:"title"`
+	Link         string        `json:"link"`
+	Rank         int           `json:"rank"`
+	Highlight    string        `json:"highlight"`
+	App          *App          `json:"app"`
+	Space        *Space        `json:"space"`
+	Organization *Organization `json:"org"`
+	CreatedBy    ByLine        `json:"created_by"`
+	CreatedOn    Time          `json:"created_on"`
+}
+
+// SearchResults is a page of search results
+type SearchResults struct {
+	// Counts is the total number of matches per type, if requested
+	Counts  map[RefType]int `json:"counts"`
+	Results []*SearchResult `json:"results"`
+}
+
+// SearchOptions are the optional parameters of a search
+type SearchOptions struct {
+	// RefType limits the search to objects of a type, e.g. item, task or file
+	RefType RefType
+
+	// Counts includes the number of matches per type in the results
+	Counts bool
+
+	// Highlights includes the matching text of each result
+	Highlights bool
+
+	// SearchFields limits the search to the given fields, e.g. title
+	SearchFields []string
+
+	// Paging. Limit defaults to 20 which is also the maximum.
+	Limit  int
+	Offset int
+}
+
+// maxSearchLimit is the most results podio returns for a search request
+const maxSearchLimit = 20
+
+func (opts SearchOptions) params(query string) map[string]interface{} {
+	params := map[string]interface{}{
+		"query": query,
+	}
+	if opts.RefType != "" {
+		params["ref_type"] = opts.RefType
+	}
+	if opts.Counts {
+		params["counts"] = true
+	}
+	if opts.Highlights {
+		params["highlights"] = true
+	}
+	if len(opts.SearchFields) > 0 {
+		params["search_fields"] = strings.Join(opts.SearchFields, ",")
+	}
+	if opts.Limit > 0 {
+		params["limit"] = opts.Limit
+	}
+	if opts.Offset > 0 {
+		params["offset"] = opts.Offset
+	}
+	return params
+}
+
+// searchPath returns the search endpoint for an org, space or app.
+// The zero Ref searches everything the active user has access to.
+func searchPath(scope Ref) (string, error) {
+	if scope == (Ref{}) {
+		return "/search/v2", nil
+	}
+	if err := scope.validateAs(RefOrg, RefSpace, RefApp); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/search/%s/%d/v2", scope.Type, scope.Id), nil
+}
+
+func (client *Client) search(scope Ref, query string, opts SearchOptions) (results *SearchResults, err error) {
+	if opts.RefType != "" && !opts.RefType.Valid() {
+		return nil, fmt.Errorf("invalid search ref type %q", opts.RefType)
+	}
+	path, err := searchPath(scope)
+	if err != nil {
+		return nil, err
+	}
+	err = client.RequestWithParams("GET", path, nil, opts.params(query), &results)
+	return
+}
+
+// Search searches everything the active user has access to.
+func (client *Client) Search(query string, opts SearchOptions) (*SearchResults, error) {
+	return client.search(Ref{}, query, opts)
+}
+
+// SearchOrganization searches the spaces of an organization the active user is a member of.
+func (client *Client) SearchOrganization(orgId int64, query string, opts SearchOptions) (*SearchResults, error) {
+	return client.search(Ref{RefOrg, orgId}, query, opts)
+}
+
+// SearchSpace searches a space.
+func (client *Client) SearchSpace(spaceId int64, query string, opts SearchOptions) (*SearchResults, error) {
+	return client.search(Ref{RefSpace, spaceId}, query, opts)
+}
+
+// SearchApp searches the items of an app.
+func (client *Client) SearchApp(appId int64, query string, opts SearchOptions) (*SearchResults, error) {
+	return client.search(Ref{RefApp, appId}, query, opts)
+}
+
+// SearchIterator pages through all the results of a search one result at a time.
+// It is used like StreamIterator.
+type SearchIterator struct {
+	client *Client
+	scope  Ref
+	query  string
+	opts   SearchOptions
+
+	counts map[RefType]int
+	page   []*SearchResult
+	pos    int
+	done   bool
+	err    error
+}
+
+// NewSearchIterator returns an iterator over the results of a search in the org, space or app identified by scope.
+// The zero Ref searches everything the active user has access to.
+// opts.Limit is used as the page size, and is at most 20.
+func (client *Client) NewSearchIterator(scope Ref, query string, opts SearchOptions) *SearchIterator {
+	if opts.Limit <= 0 || opts.Limit > maxSearchLimit {
+		opts.Limit = maxSearchLimit
+	}
+
+	return &SearchIterator{
+		client: client,
+		scope:  scope,
+		query:  query,
+		opts:   opts,
+	}
+}
+
+// Next advances to the next result. It returns false when there are no more
+// results or an error occurred.
+func (it *SearchIterator) Next() bool {
+	for it.pos >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+
+	it.pos++
+	return true
+}
+
+// Result returns the current result.
+func (it *SearchIterator) Result() *SearchResult {
+	return it.page[it.pos-1]
+}
+
+// Counts returns the number of matches per type if SearchOptions.Counts was set.
+// It is available once Next has been called.
+func (it *SearchIterator) Counts() map[RefType]int {
+	return it.counts
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+func (it *SearchIterator) fetch() {
+	results, err := it.client.search(it.scope, it.query, it.opts)
+	if err != nil {
+		it.err = err
+		return
+	}
+	if results == nil {
+		results = &SearchResults{}
+	}
+
+	if it.counts == nil {
+		it.counts = results.Counts
+	}
+	it.page, it.pos = results.Results, 0
+	it.done = len(results.Results) < it.opts.Limit
+	it.opts.Offset += len(results.Results)
+}
@@ -0,0 +1,78 @@
+package podio
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// searchServer serves n results for any query, at most 20 per page like Podio.
+// The limits it was asked for are recorded in limits.
+func searchServer(n int, limits *[]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		*limits = append(*limits, limit)
+
+		results := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < offset+20 && i < n; i++ {
+			results = append(results, map[string]interface{}{"id": i + 1, "type": "item"})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"counts":  map[string]int{"item": n},
+			"results": results,
+		})
+	}
+}
+
+func collectSearch(it *SearchIterator) (ids []int64) {
+	for it.Next() {
+		ids = append(ids, it.Result().Id)
+	}
+	return
+}
+
+func TestSearchIterator(t *testing.T) {
+	r := require.New(t)
+
+	var limits []int
+	client := newTestClient(searchServer(45, &limits))
+	it := client.NewSearchIterator(Ref{RefSpace, 1}, "query", SearchOptions{Counts: true, Limit: 50})
+
+	ids := collectSearch(it)
+	r.NoError(it.Err())
+	r.Len(ids, 45)
+	r.Equal(int64(1), ids[0])
+	r.Equal(int64(45), ids[44])
+	r.Equal([]int{20, 20, 20}, limits)
+	r.Equal(45, it.Counts()[RefItem])
+}
+
+func TestSearchIteratorPageSize(t *testing.T) {
+	r := require.New(t)
+
+	var limits []int
+	client := newTestClient(searchServer(7, &limits))
+	it := client.NewSearchIterator(Ref{}, "query", SearchOptions{Limit: 3})
+
+	r.Equal([]int64{1, 2, 3, 4, 5, 6, 7}, collectSearch(it))
+	r.NoError(it.Err())
+	r.Equal([]int{3, 3, 3}, limits)
+}
+
+func TestSearchIteratorError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "forbidden", "error_description": "nope"}`))
+	})
+	it := client.NewSearchIterator(Ref{}, "query", SearchOptions{})
+
+	r.False(it.Next())
+	r.EqualError(it.Err(), "forbidden: nope")
+}
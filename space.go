@@ -3,12 +3,38 @@ package podio
 import "fmt"
 
 type Space struct {
-	Id       int64  `json:"space_id"`
-	Name     string `json:"name"`
-	URL      string `json:"url"`
-	URLLabel string `json:"url_label"`
-	OrgId    int64  `json:"org_id"`
-	Push     Push   `json:"push"`
+	Id          int64     `json:"space_id"`
+	Name        string    `json:"name"`
+	URL         string    `json:"url"`
+	URLLabel    string    `json:"url_label"`
+	OrgId       int64     `json:"org_id"`
+	Push        Push      `json:"push"`
+	Description string    `json:"description"`
+	Privacy     string    `json:"privacy"` // open or closed
+	AutoJoin    bool      `json:"auto_join"`
+	Type        string    `json:"type"`
+	Role        SpaceRole `json:"role"`
+	CreatedBy   ByLine    `json:"created_by"`
+	CreatedOn   Time      `json:"created_on"`
+}
+
+// SpaceRole is the role a member has on a space
+type SpaceRole string
+
+const (
+	SpaceRoleLight   SpaceRole = "light"
+	SpaceRoleRegular SpaceRole = "regular"
+	SpaceRoleAdmin   SpaceRole = "admin"
+)
+
+// SpaceMember is the membership of a user on a space
+type SpaceMember struct {
+	Profile   Contact   `json:"profile"`
+	Role      SpaceRole `json:"role"`
+	Employee  bool      `json:"employee"`
+	InvitedOn *Time     `json:"invited_on"`
+	StartedOn *Time     `json:"started_on"`
+	EndedOn   *Time     `json:"ended_on"`
 }
 
 func (client *Client) GetSpaces(orgId int64) (spaces []Space, err error) {
@@ -28,3 +54,140 @@ func (client *Client) GetSpaceByOrgIdAndSlug(orgId int64, slug string) (space *S
 	err = client.Request("GET", path, nil, nil, &space)
 	return
 }
+
+// CreateSpace creates a space in an organization and returns its id.
+// Additional parameters (privacy, auto_join, post_on_new_app, post_on_new_member) can be set in the params map.
+//
+// https://developers.podio.com/doc/spaces
+func (client *Client) CreateSpace(orgId int64, name string, params map[string]interface{}) (int64, error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["org_id"] = orgId
+	params["name"] = name
+
+	rsp := &struct {
+		SpaceId int64 `json:"space_id"`
+	}{}
+	err := client.RequestWithParams("POST", "/space/", nil, params, rsp)
+
+	return rsp.SpaceId, err
+}
+
+// UpdateSpace updates the name, description, privacy, ... of a space given in the params map.
+//
+// https://developers.podio.com/doc/spaces
+func (client *Client) UpdateSpace(spaceId int64, params map[string]interface{}) error {
+	path := fmt.Sprintf("/space/%d", spaceId)
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
+// ArchiveSpace archives a space, hiding it from the members without deleting it.
+//
+// https://developers.podio.com/doc/spaces
+func (client *Client) ArchiveSpace(spaceId int64) error {
+	path := fmt.Sprintf("/space/%d/archive", spaceId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// DeleteSpace deletes a space along with all apps and items in it.
+//
+// https://developers.podio.com/doc/spaces
+func (client *Client) DeleteSpace(spaceId int64) error {
+	path := fmt.Sprintf("/space/%d", spaceId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// GetSpaceMembers returns the active members of a space.
+// The page is controlled with limit and offset in the params map.
+//
+// https://developers.podio.com/doc/space-members
+func (client *Client) GetSpaceMembers(spaceId int64, params map[string]interface{}) (members []*SpaceMember, err error) {
+	path := fmt.Sprintf("/space/%d/member/", spaceId)
+	err = client.RequestWithParams("GET", path, nil, params, &members)
+	return
+}
+
+// GetEndedSpaceMembers returns the former members of a space.
+//
+// https://developers.podio.com/doc/space-members
+func (client *Client) GetEndedSpaceMembers(spaceId int64) (members []*SpaceMember, err error) {
+	path := fmt.Sprintf("/space/%d/member/ended/", spaceId)
+	err = client.Request("GET", path, nil, nil, &members)
+	return
+}
+
+// GetSpaceMember returns the membership of a user on a space.
+//
+// https://developers.podio.com/doc/space-members
+func (client *Client) GetSpaceMember(spaceId, userId int64) (member *SpaceMember, err error) {
+	path := fmt.Sprintf("/space/%d/member/%d", spaceId, userId)
+	err = client.Request("GET", path, nil, nil, &member)
+	return
+}
+
+// AddSpaceMembers adds existing users by user id and invites new users by
+// mail address to a space with the given role. message is included in the invitation.
+//
+// https://developers.podio.com/doc/space-members
+func (client *Client) AddSpaceMembers(spaceId int64, role SpaceRole, userIds []int64, mails []string, message string) error {
+	path := fmt.Sprintf("/space/%d/member/", spaceId)
+	params := map[string]interface{}{
+		"role": role,
+	}
+	if len(userIds) > 0 {
+		params["users"] = userIds
+	}
+	if len(mails) > 0 {
+		params["mails"] = mails
+	}
+	if message != "" {
+		params["message"] = message
+	}
+
+	return client.RequestWithParams("POST", path, nil, params, nil)
+}
+
+// UpdateSpaceMemberRole changes the role of a member of a space.
+//
+// https://developers.podio.com/doc/space-members
+func (client *Client) UpdateSpaceMemberRole(spaceId, userId int64, role SpaceRole) error {
+	path := fmt.Sprintf("/space/%d/member/%d", spaceId, userId)
+	params := map[string]interface{}{
+		"role": role,
+	}
+
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
+// EndSpaceMembership removes a user from a space.
+//
+// https://developers.podio.com/doc/space-members
+func (client *Client) EndSpaceMembership(spaceId, userId int64) error {
+	path := fmt.Sprintf("/space/%d/member/%d", spaceId, userId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// JoinSpace makes the active user a member of an open space.
+//
+// https://developers.podio.com/doc/spaces
+func (client *Client) JoinSpace(spaceId int64) error {
+	path := fmt.Sprintf("/space/%d/join", spaceId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// LeaveSpace ends the membership of the active user on a space.
+//
+// https://developers.podio.com/doc/spaces
+func (client *Client) LeaveSpace(spaceId int64) error {
+	path := fmt.Sprintf("/space/%d/leave", spaceId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// RequestSpaceMembership asks the admins of a closed space to add the active user.
+//
+// https://developers.podio.com/doc/space-members
+func (client *Client) RequestSpaceMembership(spaceId int64) error {
+	path := fmt.Sprintf("/space/%d/member_request/", spaceId)
+	return client.Request("POST", path, nil, nil, nil)
+}
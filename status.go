@@ -0,0 +1,62 @@
+package podio
+
+import "fmt"
+
+// Status is a status message posted on a space
+type Status struct {
+	Id         int64      `json:"status_id"`
+	Value      string     `json:"value"`
+	RichValue  string     `json:"rich_value"`
+	Link       string     `json:"link"`
+	Ref        *Reference `json:"ref"`
+	Space      *Space     `json:"space"`
+	Files      []*File    `json:"files"`
+	Embed      *Embed     `json:"embed"`
+	EmbedFile  *File      `json:"embed_file"`
+	Comments   []*Comment `json:"comments"`
+	CreatedBy  ByLine     `json:"created_by"`
+	CreatedVia Via        `json:"created_via"`
+	CreatedOn  Time       `json:"created_on"`
+	IsLiked    bool       `json:"is_liked"`
+	LikeCount  int        `json:"like_count"`
+	Push       Push       `json:"push"`
+}
+
+// CreateStatus posts a status message on a space.
+// Additional parameters (file_ids, embed_id, embed_url, ...) can be set in the params map.
+//
+// https://developers.podio.com/doc/status/add-new-status-message-22336
+func (client *Client) CreateStatus(spaceId int64, text string, params map[string]interface{}) (status *Status, err error) {
+	path := fmt.Sprintf("/status/space/%d/", spaceId)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["value"] = text
+
+	err = client.RequestWithParams("POST", path, nil, params, &status)
+	return
+}
+
+// https://developers.podio.com/doc/status/get-status-message-22337
+func (client *Client) GetStatus(statusId int64) (status *Status, err error) {
+	path := fmt.Sprintf("/status/%d", statusId)
+	err = client.Request("GET", path, nil, nil, &status)
+	return
+}
+
+// https://developers.podio.com/doc/status/update-a-status-message-22338
+func (client *Client) UpdateStatus(statusId int64, text string, params map[string]interface{}) error {
+	path := fmt.Sprintf("/status/%d", statusId)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["value"] = text
+
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
+// https://developers.podio.com/doc/status/delete-a-status-message-22339
+func (client *Client) DeleteStatus(statusId int64) error {
+	path := fmt.Sprintf("/status/%d", statusId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
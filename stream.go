@@ -0,0 +1,228 @@
+package podio
+
+import (
+	"fmt"
+	"time"
+)
+
+// StreamObject is an object (item, status, task, ...) in a Podio stream along
+// with the latest activity, comments and files on it.
+type StreamObject struct {
+	Id              int64                  `json:"id"`
+	Type            RefType                `json:"type"`
+	Title           string                 `json:"title"`
+	Link            string                 `json:"link"`
+	Data            map[string]interface{} `json:"data"`
+	CommentsAllowed bool                   `json:"comments_allowed"`
+	Space           *Space                 `json:"space"`
+	App             *App                   `json:"app"`
+	Organization    *Organization          `json:"org"`
+	Activities      []*StreamActivity      `json:"activity"`
+	Comments        []*Comment             `json:"comments"`
+	Files           []*File                `json:"files"`
+	CreatedBy       ByLine                 `json:"created_by"`
+	CreatedVia      Via                    `json:"created_via"`
+	CreatedOn       Time                   `json:"created_on"`
+	LastUpdateOn    Time                   `json:"last_update_on"`
+	Push            Push                   `json:"push"`
+}
+
+// StreamActivity describes a single event on a stream object
+type StreamActivity struct {
+	Id   int64   `json:"id"`
+	Type RefType `json:"type"`
+	// creation, update, comment, file, rating, ...
+	ActivityType string                 `json:"activity_type"`
+	Data         map[string]interface{} `json:"data"`
+	CreatedBy    ByLine                 `json:"created_by"`
+	CreatedVia   Via                    `json:"created_via"`
+	CreatedOn    Time                   `json:"created_on"`
+}
+
+// streamPath returns the stream endpoint for an org, space or app.
+// The zero Ref is the global stream of the active user.
+func streamPath(scope Ref) (string, error) {
+	if scope == (Ref{}) {
+		return "/stream/", nil
+	}
+	if err := scope.validateAs(RefOrg, RefSpace, RefApp); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/stream/%s/%d/", scope.Type, scope.Id), nil
+}
+
+func (client *Client) getStream(scope Ref, params map[string]interface{}) (objects []*StreamObject, err error) {
+	path, err := streamPath(scope)
+	if err != nil {
+		return nil, err
+	}
+	err = client.RequestWithParams("GET", path, nil, params, &objects)
+	return
+}
+
+// GetGlobalStream returns the stream of the active user.
+// The page is controlled with limit, offset, date_from and date_to in the params map.
+//
+// https://developers.podio.com/doc/stream/get-global-stream-80012
+func (client *Client) GetGlobalStream(params map[string]interface{}) ([]*StreamObject, error) {
+	return client.getStream(Ref{}, params)
+}
+
+// https://developers.podio.com/doc/stream/get-organization-stream-80038
+func (client *Client) GetOrganizationStream(orgId int64, params map[string]interface{}) ([]*StreamObject, error) {
+	return client.getStream(Ref{RefOrg, orgId}, params)
+}
+
+// https://developers.podio.com/doc/stream/get-space-stream-80039
+func (client *Client) GetSpaceStream(spaceId int64, params map[string]interface{}) ([]*StreamObject, error) {
+	return client.getStream(Ref{RefSpace, spaceId}, params)
+}
+
+// https://developers.podio.com/doc/stream/get-app-stream-264673
+func (client *Client) GetAppStream(appId int64, params map[string]interface{}) ([]*StreamObject, error) {
+	return client.getStream(Ref{RefApp, appId}, params)
+}
+
+// GetStreamObject returns the stream object for a single podio object.
+//
+// https://developers.podio.com/doc/stream/get-stream-object-80054
+func (client *Client) GetStreamObject(ref Ref) (object *StreamObject, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/stream/%s/%d", ref.Type, ref.Id)
+	err = client.Request("GET", path, nil, nil, &object)
+	return
+}
+
+// StreamOptions controls how a StreamIterator pages through a stream.
+type StreamOptions struct {
+	// Number of objects fetched per request. Defaults to 10.
+	PageSize int
+
+	// ByDate pages using date_to set to the last update of the previous page
+	// instead of an offset. This does not skip or repeat objects when new
+	// activity moves objects to the top of the stream while paging.
+	ByDate bool
+
+	// Only objects updated in this range are returned, if set.
+	DateFrom time.Time
+	DateTo   time.Time
+}
+
+// StreamIterator pages through a stream one object at a time:
+//
+//	it := client.NewStreamIterator(NewRef(RefSpace, spaceId), StreamOptions{})
+//	for it.Next() {
+//		obj := it.Object()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type StreamIterator struct {
+	client *Client
+	scope  Ref
+	opts   StreamOptions
+
+	offset int
+	dateTo time.Time
+	// objects already returned with LastUpdateOn equal to dateTo
+	seen map[string]bool
+
+	page []*StreamObject
+	pos  int
+	done bool
+	err  error
+}
+
+// NewStreamIterator returns an iterator over the stream of the org, space or app identified by scope.
+// The zero Ref iterates the global stream.
+func (client *Client) NewStreamIterator(scope Ref, opts StreamOptions) *StreamIterator {
+	if opts.PageSize <= 0 {
+		opts.PageSize = 10
+	}
+
+	return &StreamIterator{
+		client: client,
+		scope:  scope,
+		opts:   opts,
+		dateTo: opts.DateTo,
+		seen:   map[string]bool{},
+	}
+}
+
+// Next advances to the next object. It returns false when the stream is
+// exhausted or an error occurred.
+func (it *StreamIterator) Next() bool {
+	for it.pos >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+
+	it.pos++
+	return true
+}
+
+// Object returns the current object.
+func (it *StreamIterator) Object() *StreamObject {
+	return it.page[it.pos-1]
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *StreamIterator) Err() error {
+	return it.err
+}
+
+func (it *StreamIterator) fetch() {
+	params := map[string]interface{}{
+		"limit":  it.opts.PageSize,
+		"offset": it.offset,
+	}
+	if !it.opts.DateFrom.IsZero() {
+		params["date_from"] = it.opts.DateFrom.UTC().Format(podioLayout)
+	}
+	if !it.dateTo.IsZero() {
+		params["date_to"] = it.dateTo.UTC().Format(podioLayout)
+	}
+
+	objects, err := it.client.getStream(it.scope, params)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page, it.pos = nil, 0
+	it.done = len(objects) < it.opts.PageSize
+
+	if !it.opts.ByDate {
+		it.page = objects
+		it.offset += len(objects)
+		return
+	}
+
+	// Objects updated at exactly date_to may be returned again, so skip
+	// those already seen. The offset is only used to move past more objects
+	// with the same update time than fits in a page.
+	boundary := it.dateTo
+	for _, obj := range objects {
+		key := fmt.Sprintf("%s:%d", obj.Type, obj.Id)
+		if it.seen[key] {
+			continue
+		}
+
+		if !obj.LastUpdateOn.Equal(it.dateTo) {
+			it.dateTo = obj.LastUpdateOn.Time
+			it.seen = map[string]bool{}
+		}
+		it.seen[key] = true
+		it.page = append(it.page, obj)
+	}
+
+	if it.dateTo.Equal(boundary) {
+		it.offset += len(objects)
+	} else {
+		it.offset = 0
+	}
+}
@@ -0,0 +1,97 @@
+package podio
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// streamServer serves a stream of objects ordered by last update, newest first.
+// date_to is treated as inclusive, like Podio does.
+func streamServer(objects []*StreamObject) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		matching := []*StreamObject{}
+		for _, obj := range objects {
+			if dateTo := q.Get("date_to"); dateTo != "" {
+				tm, _ := time.ParseInLocation(podioLayout, dateTo, time.UTC)
+				if obj.LastUpdateOn.After(tm) {
+					continue
+				}
+			}
+			matching = append(matching, obj)
+		}
+
+		page := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < len(matching); i++ {
+			page = append(page, map[string]interface{}{
+				"type":           matching[i].Type,
+				"id":             matching[i].Id,
+				"last_update_on": matching[i].LastUpdateOn.Format(podioLayout),
+			})
+		}
+		json.NewEncoder(w).Encode(page)
+	}
+}
+
+func testStreamObjects() []*StreamObject {
+	start := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC)
+	timestamps := []int{10, 9, 9, 9, 9, 8, 7, 7, 6}
+
+	objects := []*StreamObject{}
+	for i, ts := range timestamps {
+		objects = append(objects, &StreamObject{
+			Type:         RefItem,
+			Id:           int64(i + 1),
+			LastUpdateOn: Time{start.Add(time.Duration(ts) * time.Minute)},
+		})
+	}
+	return objects
+}
+
+func collectStream(it *StreamIterator) (ids []int64) {
+	for it.Next() {
+		ids = append(ids, it.Object().Id)
+	}
+	return
+}
+
+func TestStreamIteratorByOffset(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(streamServer(testStreamObjects()))
+	it := client.NewStreamIterator(Ref{RefSpace, 1}, StreamOptions{PageSize: 2})
+
+	r.Equal([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, collectStream(it))
+	r.NoError(it.Err())
+}
+
+func TestStreamIteratorByDate(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(streamServer(testStreamObjects()))
+	it := client.NewStreamIterator(Ref{RefSpace, 1}, StreamOptions{PageSize: 2, ByDate: true})
+
+	r.Equal([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, collectStream(it))
+	r.NoError(it.Err())
+}
+
+func TestStreamIteratorError(t *testing.T) {
+	r := require.New(t)
+
+	client := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "forbidden", "error_description": "nope"}`))
+	})
+	it := client.NewStreamIterator(Ref{}, StreamOptions{})
+
+	r.False(it.Next())
+	r.EqualError(it.Err(), "forbidden: nope")
+}
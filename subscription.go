@@ -0,0 +1,80 @@
+package podio
+
+import "fmt"
+
+// Subscription is the subscription of the active user to notifications about a podio object
+type Subscription struct {
+	Id        int64      `json:"subscription_id"`
+	StartedOn Time       `json:"started_on"`
+	Ref       *Reference `json:"ref"`
+
+	// Notifications is the number of notifications received through the subscription
+	Notifications int `json:"notifications"`
+}
+
+// Subscribe subscribes the active user to notifications about the podio object
+// identified by ref and returns the id of the subscription.
+//
+// https://developers.podio.com/doc/subscriptions
+func (client *Client) Subscribe(ref Ref) (int64, error) {
+	if err := ref.Validate(); err != nil {
+		return 0, err
+	}
+	path := fmt.Sprintf("/subscription/%s/%d", ref.Type, ref.Id)
+
+	rsp := &struct {
+		SubscriptionId int64 `json:"subscription_id"`
+	}{}
+	err := client.Request("POST", path, nil, nil, rsp)
+
+	return rsp.SubscriptionId, err
+}
+
+// Unsubscribe ends the subscription of the active user to the podio object identified by ref.
+//
+// https://developers.podio.com/doc/subscriptions
+func (client *Client) Unsubscribe(ref Ref) error {
+	if err := ref.Validate(); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/subscription/%s/%d", ref.Type, ref.Id)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// UnsubscribeById ends a subscription.
+//
+// https://developers.podio.com/doc/subscriptions
+func (client *Client) UnsubscribeById(subscriptionId int64) error {
+	path := fmt.Sprintf("/subscription/%d", subscriptionId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// GetSubscription returns a single subscription.
+//
+// https://developers.podio.com/doc/subscriptions
+func (client *Client) GetSubscription(subscriptionId int64) (subscription *Subscription, err error) {
+	path := fmt.Sprintf("/subscription/%d", subscriptionId)
+	err = client.Request("GET", path, nil, nil, &subscription)
+	return
+}
+
+// GetSubscriptionByRef returns the subscription of the active user to the podio object identified by ref.
+//
+// https://developers.podio.com/doc/subscriptions
+func (client *Client) GetSubscriptionByRef(ref Ref) (subscription *Subscription, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/subscription/%s/%d", ref.Type, ref.Id)
+	err = client.Request("GET", path, nil, nil, &subscription)
+	return
+}
+
+// GetSubscriptions returns the subscriptions of the active user.
+// The page is controlled with limit and offset in the params map.
+//
+// https://developers.podio.com/doc/subscriptions
+func (client *Client) GetSubscriptions(params map[string]interface{}) (subscriptions []*Subscription, err error) {
+	err = client.RequestWithParams("GET", "/subscription/", nil, params, &subscriptions)
+	return
+}
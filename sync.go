@@ -0,0 +1,457 @@
+package podio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// SyncField maps a field of the source app to a field of the target app
+type SyncField struct {
+	// Source and Target are the external ids (or field ids) of the fields
+	Source string
+	Target string
+
+	// Transform, if not nil, converts the source field to the value set on
+	// the target field, replacing the conversion by field type. field is nil
+	// if the source item has no value in the field.
+	Transform func(field *Field) (interface{}, error)
+}
+
+// SyncState is the progress of an AppSync, persisted between runs by a SyncStore
+type SyncState struct {
+	// Watermark is the last edit time of the items synced so far. Items last
+	// edited at or after it are synced on the next run.
+	Watermark time.Time `json:"watermark"`
+
+	// Files maps the ids of files in the source app to their copies in the target app
+	Files map[int64]int64 `json:"files"`
+}
+
+// SyncStore persists the state of an AppSync
+type SyncStore interface {
+	Load() (*SyncState, error)
+	Save(*SyncState) error
+}
+
+// SyncFileStore stores the state of an AppSync as JSON in a file
+type SyncFileStore struct {
+	Path string
+}
+
+// Load returns the stored state, or an empty state if the file does not exist
+func (store SyncFileStore) Load() (*SyncState, error) {
+	state := &SyncState{}
+	buf, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return state, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return state, json.Unmarshal(buf, state)
+}
+
+// Save writes the state to a temporary file which then replaces the file,
+// so an interrupted save never leaves a corrupt state behind.
+func (store SyncFileStore) Save(state *SyncState) error {
+	buf, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(store.Path), filepath.Base(store.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(buf); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), store.Path)
+}
+
+// SyncReport is the outcome of a run of an AppSync
+type SyncReport struct {
+	Created   int
+	Updated   int
+	Unchanged int
+
+	// Errors holds the items which could not be synced by source item id
+	Errors map[int64]error
+}
+
+// AppSync mirrors the items of a source app to a target app, possibly in
+// another organization. Each run syncs the items edited since the previous
+// one: items are created in the target app with the external id "podio:<id>"
+// of the source item, or updated where their values differ.
+//
+// Values are converted by the type of the source field:
+//
+//   - references in app fields are re-linked to the items synced from the
+//     referenced items, for the apps in Links. References to other apps are
+//     kept as is, which is only valid if the target app can reference them too.
+//   - files in image fields are copied to the target, or uploaded again when
+//     the target client is not the source client.
+//   - categories are set by the text of their options, as the option ids of
+//     the apps differ.
+//
+// Failed items are reported and retried on the next run, as the watermark
+// is not moved past them.
+type AppSync struct {
+	Source      *Client
+	SourceAppId int64
+	Target      *Client
+	TargetAppId int64
+
+	Fields []SyncField
+
+	// Links maps the ids of apps referenced from the source app to the apps
+	// their items are synced to.
+	Links map[int64]int64
+
+	// Store persists the state between runs. If nil, every run syncs all items.
+	Store SyncStore
+
+	// PageSize is the number of items fetched at a time. Defaults to 100,
+	// and is at most 500.
+	PageSize int
+
+	state *SyncState
+	links map[int64]int64 // source item id -> target item id
+}
+
+func NewAppSync(source *Client, sourceAppId int64, target *Client, targetAppId int64) *AppSync {
+	return &AppSync{
+		Source:      source,
+		SourceAppId: sourceAppId,
+		Target:      target,
+		TargetAppId: targetAppId,
+		PageSize:    100,
+	}
+}
+
+// syncExternalId is the external id of the item synced from the source item
+func syncExternalId(sourceItemId int64) string {
+	return "podio:" + strconv.FormatInt(sourceItemId, 10)
+}
+
+// Run syncs the items edited since the previous run. The returned error is
+// set if the run was aborted; errors syncing single items are in the report.
+func (s *AppSync) Run(ctx context.Context) (*SyncReport, error) {
+	s.state = &SyncState{}
+	if s.Store != nil {
+		state, err := s.Store.Load()
+		if err != nil {
+			return nil, err
+		}
+		s.state = state
+	}
+	if s.state.Files == nil {
+		s.state.Files = map[int64]int64{}
+	}
+	s.links = map[int64]int64{}
+
+	report := &SyncReport{Errors: map[int64]error{}}
+	pageSize := s.PageSize
+	if pageSize <= 0 {
+		pageSize = 100
+	} else if pageSize > maxFilterLimit {
+		pageSize = maxFilterLimit
+	}
+
+	// The watermark is inclusive, as several items can be edited within the
+	// second it is precise to; items synced before are found unchanged.
+	//
+	// Pages are fetched from the last edit time of the previous page rather
+	// than by offset, as items edited while syncing move to the end and would
+	// shift others past the page boundary. Items edited at exactly that time
+	// are returned again and skipped; the offset is only used to move past
+	// more of them than fit in a page.
+	from := s.state.Watermark
+	seen := map[int64]bool{}
+	offset := 0
+	failed := false
+	for {
+		params := map[string]interface{}{
+			"sort_by":   "last_edit_on",
+			"sort_desc": false,
+			"limit":     pageSize,
+			"offset":    offset,
+		}
+		if !from.IsZero() {
+			params["filters"] = ItemFilter{}.LastEditOn(from, time.Time{})
+		}
+
+		items, err := s.Source.FilterItems(s.SourceAppId, params)
+		if err != nil {
+			return report, err
+		}
+
+		boundary := from
+		for _, item := range items.Items {
+			if seen[item.Id] {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+
+			action, err := s.syncItem(ctx, item)
+			switch {
+			case err != nil:
+				report.Errors[item.Id] = err
+				failed = true
+			case action == UpsertCreated:
+				report.Created++
+			case action == UpsertUpdated:
+				report.Updated++
+			default:
+				report.Unchanged++
+			}
+
+			if !failed {
+				s.state.Watermark = item.LastEditOn.Time
+			}
+
+			if !item.LastEditOn.Equal(from) {
+				from = item.LastEditOn.Time
+				seen = map[int64]bool{}
+			}
+			seen[item.Id] = true
+		}
+
+		if err := s.save(); err != nil {
+			return report, err
+		}
+		// pages can be shorter than asked for, so only the total of the
+		// query tells when all items have been returned
+		if len(items.Items) == 0 || offset+len(items.Items) >= items.Filtered {
+			return report, nil
+		}
+
+		if from.Equal(boundary) {
+			offset += len(items.Items)
+		} else {
+			offset = 0
+		}
+	}
+}
+
+func (s *AppSync) save() error {
+	if s.Store == nil {
+		return nil
+	}
+	return s.Store.Save(s.state)
+}
+
+func (s *AppSync) syncItem(ctx context.Context, item *Item) (UpsertAction, error) {
+	values := map[string]interface{}{}
+	for _, mapping := range s.Fields {
+		field := item.field(mapping.Source)
+
+		var value interface{}
+		var err error
+		if mapping.Transform != nil {
+			value, err = mapping.Transform(field)
+		} else {
+			value, err = s.convert(ctx, field)
+		}
+		if err != nil {
+			return "", fmt.Errorf("field %s: %s", mapping.Source, err)
+		}
+		values[mapping.Target] = value
+	}
+
+	itemId, action, err := s.Target.UpsertItemByExternalID(s.TargetAppId, syncExternalId(item.Id), values, nil)
+	if err == nil {
+		s.links[item.Id] = itemId
+	}
+	return action, err
+}
+
+// convert returns the value of field as set on the target
+func (s *AppSync) convert(ctx context.Context, field *Field) (interface{}, error) {
+	values := []interface{}{}
+	if field == nil {
+		return values, nil
+	}
+
+	switch vs := field.Values.(type) {
+	case []TextValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []NumberValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []MoneyValue:
+		for _, v := range vs {
+			values = append(values, map[string]interface{}{"value": v.Value, "currency": v.Currency})
+		}
+	case []ProgressValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []DurationValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []MemberValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []QuestionValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []VideoValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []ContactValue:
+		for _, v := range vs {
+			values = append(values, v.Value.ProfileId)
+		}
+	case []CategoryValue:
+		for _, v := range vs {
+			values = append(values, v.Value.Text)
+		}
+	case []LocationValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []CalculationValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []TelValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []EmailValue:
+		for _, v := range vs {
+			values = append(values, map[string]interface{}{"type": v.Type, "value": v.Value})
+		}
+	case []PhoneValue:
+		for _, v := range vs {
+			values = append(values, map[string]interface{}{"type": v.Type, "value": v.Value})
+		}
+	case []EmbedValue:
+		for _, v := range vs {
+			values = append(values, map[string]interface{}{"embed": v.Embed.Id})
+		}
+	case []DateValue:
+		for _, v := range vs {
+			date := map[string]interface{}{}
+			if v.Start != nil {
+				date["start_utc"] = v.Start.Format(podioLayout)
+			}
+			if v.End != nil {
+				date["end_utc"] = v.End.Format(podioLayout)
+			}
+			values = append(values, date)
+		}
+	case []AppValue:
+		for _, v := range vs {
+			itemId, err := s.relink(&v.Value)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, itemId)
+		}
+	case []ImageValue:
+		for _, v := range vs {
+			fileId, err := s.copyFile(ctx, &v.Value)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, fileId)
+		}
+	default:
+		return nil, fmt.Errorf("cannot sync fields of type %q, use a Transform", field.Type)
+	}
+	return values, nil
+}
+
+// relink returns the id of the item in the target the reference should point to
+func (s *AppSync) relink(ref *Item) (int64, error) {
+	targetAppId, ok := s.Links[ref.App.Id]
+	if !ok {
+		return ref.Id, nil
+	}
+	if itemId, ok := s.links[ref.Id]; ok {
+		return itemId, nil
+	}
+
+	item, err := s.Target.GetItemByExternalID(targetAppId, syncExternalId(ref.Id))
+	if podioErr, ok := err.(*Error); ok && podioErr.Type == "not_found" {
+		return 0, fmt.Errorf("referenced item %d has not been synced to app %d yet", ref.Id, targetAppId)
+	} else if err != nil {
+		return 0, err
+	}
+	s.links[ref.Id] = item.Id
+	return item.Id, nil
+}
+
+// copyFile returns the id of the copy of file in the target, copying it if needed
+func (s *AppSync) copyFile(ctx context.Context, file *File) (int64, error) {
+	if fileId, ok := s.state.Files[file.Id]; ok {
+		return fileId, nil
+	}
+
+	var fileId int64
+	var err error
+	if s.Source == s.Target {
+		fileId, err = s.Source.CopyFile(int(file.Id))
+	} else {
+		fileId, err = s.reupload(ctx, file)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("copying file %d: %s", file.Id, err)
+	}
+
+	s.state.Files[file.Id] = fileId
+	return fileId, nil
+}
+
+// reupload streams file from the source to the target
+func (s *AppSync) reupload(ctx context.Context, file *File) (int64, error) {
+	if file.Size == 0 {
+		// the size is needed up front, so buffer files of unknown size
+		buf := &bytes.Buffer{}
+		if _, err := s.Source.DownloadFile(ctx, file, buf, nil); err != nil {
+			return 0, err
+		}
+		copied, err := s.Target.UploadFile(file.Name, buf, int64(buf.Len()))
+		if err != nil {
+			return 0, err
+		}
+		return copied.Id, nil
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		_, err := s.Source.DownloadFile(ctx, file, w, nil)
+		w.CloseWithError(err)
+	}()
+
+	copied, err := s.Target.UploadFile(file.Name, r, int64(file.Size))
+	r.CloseWithError(err) // unblocks the download if the upload failed
+	if err != nil {
+		return 0, err
+	}
+	return copied.Id, nil
+}
@@ -0,0 +1,217 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const syncSourceItems = `[
+	{"item_id": 10, "last_edit_on": "2024-01-02 10:00:00", "fields": [
+		{"field_id": 1, "external_id": "title", "type": "text", "values": [{"value": "Hello"}]},
+		{"field_id": 2, "external_id": "photo", "type": "image", "values": [{"value": {"file_id": 7, "name": "a.png", "link": "https://files.podio.com/7", "size": 5}}]},
+		{"field_id": 3, "external_id": "parent", "type": "app", "values": [{"value": {"item_id": 30, "app": {"app_id": 3}}}]}
+	]},
+	{"item_id": 11, "last_edit_on": "2024-01-03 10:00:00", "fields": [
+		{"field_id": 1, "external_id": "title", "type": "text", "values": [{"value": "World"}]}
+	]}
+]`
+
+// syncServer serves the source app 1 and the target app 2, which references
+// app 4 holding item 40 synced from item 30.
+type syncServer struct {
+	mu sync.Mutex
+	// source, if set, returns the response to a filter request on app 1
+	source  func(body []byte) string
+	filters []string
+	target  map[string]map[string]json.RawMessage // external id -> fields
+	writes  int
+	uploads int
+}
+
+func (s *syncServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// read first, as uploads are streamed from downloads served here too
+	body, _ := ioutil.ReadAll(req.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case req.URL.Path == "/item/app/1/filter":
+		s.filters = append(s.filters, string(body))
+		if s.source != nil {
+			w.Write([]byte(s.source(body)))
+			return
+		}
+		w.Write([]byte(`{"filtered": 2, "items": ` + syncSourceItems + `}`))
+	case req.URL.Host == "files.podio.com":
+		w.Write([]byte("image"))
+	case req.URL.Path == "/file":
+		s.uploads++
+		fmt.Fprintf(w, `{"file_id": %d}`, 70+s.uploads)
+	case req.URL.Path == "/item/app/4/external_id/podio:30":
+		w.Write([]byte(`{"item_id": 40}`))
+	case strings.HasPrefix(req.URL.Path, "/item/app/2/external_id/"):
+		fields, ok := s.target[strings.TrimPrefix(req.URL.Path, "/item/app/2/external_id/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "not_found", "error_description": "no item"}`))
+			return
+		}
+		item := `{"item_id": 20, "fields": [
+			{"field_id": 1, "external_id": "title", "type": "text", "values": %s},
+			{"field_id": 2, "external_id": "photo", "type": "image", "values": %s},
+			{"field_id": 3, "external_id": "parent", "type": "app", "values": %s}
+		]}`
+		fmt.Fprintf(w, item, wrapValues(fields["title"], ""), wrapValues(fields["photo"], "file_id"), wrapValues(fields["parent"], "item_id"))
+	case req.URL.Path == "/item/app/2":
+		params := struct {
+			ExternalId string                     `json:"external_id"`
+			Fields     map[string]json.RawMessage `json:"fields"`
+		}{}
+		json.Unmarshal(body, &params)
+		s.writes++
+		s.target[params.ExternalId] = params.Fields
+		w.Write([]byte(`{"item_id": 20}`))
+	case req.Method == "PUT":
+		s.writes++
+		w.Write([]byte(`{}`))
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+// wrapValues turns a list of values into podio values, of objects with the value in key unless key is empty
+func wrapValues(list json.RawMessage, key string) string {
+	var raw []json.RawMessage
+	json.Unmarshal(list, &raw)
+	values := []string{}
+	for _, v := range raw {
+		if key != "" {
+			v = json.RawMessage(fmt.Sprintf(`{%q: %s}`, key, v))
+		}
+		values = append(values, fmt.Sprintf(`{"value": %s}`, v))
+	}
+	return "[" + strings.Join(values, ",") + "]"
+}
+
+func TestAppSync(t *testing.T) {
+	r := require.New(t)
+
+	dir, err := ioutil.TempDir("", "podio-sync")
+	r.NoError(err)
+	defer os.RemoveAll(dir)
+
+	server := &syncServer{target: map[string]map[string]json.RawMessage{}}
+	source, target := newTestClient(server.ServeHTTP), newTestClient(server.ServeHTTP)
+
+	appSync := NewAppSync(source, 1, target, 2)
+	appSync.Fields = []SyncField{
+		{Source: "title", Target: "title"},
+		{Source: "photo", Target: "photo"},
+		{Source: "parent", Target: "parent"},
+	}
+	appSync.Links = map[int64]int64{3: 4}
+	appSync.Store = SyncFileStore{Path: filepath.Join(dir, "state.json")}
+
+	report, err := appSync.Run(context.Background())
+	r.NoError(err)
+	r.Empty(report.Errors)
+	r.Equal(2, report.Created)
+	r.Equal(1, server.uploads)
+	r.JSONEq(`[71]`, string(server.target["podio:10"]["photo"]))
+	r.JSONEq(`[40]`, string(server.target["podio:10"]["parent"]))
+	r.JSONEq(`["World"]`, string(server.target["podio:11"]["title"]))
+	r.JSONEq(`{"sort_by": "last_edit_on", "sort_desc": false, "limit": 100, "offset": 0}`, server.filters[0])
+
+	state, err := appSync.Store.Load()
+	r.NoError(err)
+	r.Equal("2024-01-03 10:00:00", state.Watermark.Format(podioLayout))
+	r.Equal(map[int64]int64{7: 71}, state.Files)
+
+	// a rerun changes nothing
+	writes := server.writes
+	report, err = appSync.Run(context.Background())
+	r.NoError(err)
+	r.Empty(report.Errors)
+	r.Equal(2, report.Unchanged)
+	r.Equal(writes, server.writes)
+	r.Equal(1, server.uploads)
+	r.Contains(server.filters[1], `"from":"2024-01-03 10:00:00"`)
+}
+
+func TestAppSyncEditedWhileSyncing(t *testing.T) {
+	r := require.New(t)
+
+	// items 1 to 4 edited in that order; item 1 is edited again after the
+	// first page. Pages hold at most 2 items, however many are asked for.
+	edits := map[int64]int{1: 1, 2: 2, 3: 3, 4: 4}
+	pages := 0
+	server := &syncServer{target: map[string]map[string]json.RawMessage{}}
+	server.source = func(body []byte) string {
+		params := struct {
+			Filters struct {
+				LastEditOn struct {
+					From string `json:"from"`
+				} `json:"last_edit_on"`
+			} `json:"filters"`
+			Limit  int `json:"limit"`
+			Offset int `json:"offset"`
+		}{}
+		json.Unmarshal(body, &params)
+
+		if pages++; pages == 2 {
+			edits[1] = 5
+		}
+
+		ids := []int64{}
+		for id, edit := range edits {
+			if fmt.Sprintf("2024-01-0%d 10:00:00", edit) >= params.Filters.LastEditOn.From {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return edits[ids[i]] < edits[ids[j]] })
+		filtered := len(ids)
+		if params.Offset > len(ids) {
+			params.Offset = len(ids)
+		}
+		ids = ids[params.Offset:]
+		if len(ids) > params.Limit {
+			ids = ids[:params.Limit]
+		}
+		if len(ids) > 2 {
+			ids = ids[:2]
+		}
+
+		items := []string{}
+		for _, id := range ids {
+			items = append(items, fmt.Sprintf(`{"item_id": %d, "last_edit_on": "2024-01-0%d 10:00:00", "fields": [
+				{"field_id": 1, "external_id": "title", "type": "text", "values": [{"value": "Item"}]}
+			]}`, id, edits[id]))
+		}
+		return fmt.Sprintf(`{"filtered": %d, "items": [%s]}`, filtered, strings.Join(items, ","))
+	}
+
+	appSync := NewAppSync(newTestClient(server.ServeHTTP), 1, newTestClient(server.ServeHTTP), 2)
+	appSync.Fields = []SyncField{{Source: "title", Target: "title"}}
+	appSync.PageSize = 1000
+
+	report, err := appSync.Run(context.Background())
+	r.NoError(err)
+	r.Empty(report.Errors)
+	r.Equal(4, report.Created)
+	for id := range edits {
+		r.Contains(server.target, syncExternalId(id))
+	}
+	r.Equal("2024-01-05 10:00:00", appSync.state.Watermark.Format(podioLayout))
+	r.Contains(server.filters[0], `"limit":500`)
+}
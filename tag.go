@@ -0,0 +1,95 @@
+package podio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// TagCount is a tag along with the number of objects it is on
+type TagCount struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+func (client *Client) requestTags(method string, ref Ref, tags []string) error {
+	if err := ref.Validate(); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/tag/%s/%d/", ref.Type, ref.Id)
+	if tags == nil {
+		tags = []string{}
+	}
+
+	buf, err := json.Marshal(tags)
+	if err != nil {
+		return err
+	}
+
+	return client.Request(method, path, nil, bytes.NewReader(buf), nil)
+}
+
+// CreateTags adds tags to the podio object identified by ref.
+func (client *Client) CreateTags(ref Ref, tags []string) error {
+	return client.requestTags("POST", ref, tags)
+}
+
+// UpdateTags replaces the tags on the podio object identified by ref.
+func (client *Client) UpdateTags(ref Ref, tags []string) error {
+	return client.requestTags("PUT", ref, tags)
+}
+
+// RemoveTag removes a single tag from the podio object identified by ref.
+func (client *Client) RemoveTag(ref Ref, tag string) error {
+	if err := ref.Validate(); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/tag/%s/%d/?text=%s", ref.Type, ref.Id, url.QueryEscape(tag))
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// GetAppTags returns the tags used on the items of an app and how often.
+// The tags can be limited with limit and text (prefix) in the params map.
+func (client *Client) GetAppTags(appId int64, params map[string]interface{}) (tags []TagCount, err error) {
+	path := fmt.Sprintf("/tag/app/%d/", appId)
+	err = client.RequestWithParams("GET", path, nil, params, &tags)
+	return
+}
+
+// GetSpaceTags returns the tags used on the objects of a space and how often.
+func (client *Client) GetSpaceTags(spaceId int64, params map[string]interface{}) (tags []TagCount, err error) {
+	path := fmt.Sprintf("/tag/space/%d/", spaceId)
+	err = client.RequestWithParams("GET", path, nil, params, &tags)
+	return
+}
+
+// GetObjectsOnAppWithTag returns the objects in an app with the given tag.
+func (client *Client) GetObjectsOnAppWithTag(appId int64, tag string) (objects []*Reference, err error) {
+	path := fmt.Sprintf("/tag/app/%d/search/", appId)
+	params := map[string]interface{}{
+		"text": tag,
+	}
+
+	err = client.RequestWithParams("GET", path, nil, params, &objects)
+	return
+}
+
+// GetObjectsOnSpaceWithTag returns the objects in a space with the given tag.
+func (client *Client) GetObjectsOnSpaceWithTag(spaceId int64, tag string) (objects []*Reference, err error) {
+	path := fmt.Sprintf("/tag/space/%d/search/", spaceId)
+	params := map[string]interface{}{
+		"text": tag,
+	}
+
+	err = client.RequestWithParams("GET", path, nil, params, &objects)
+	return
+}
+
+// GetItemsByTag returns the items in an app which have all of the given tags.
+func (client *Client) GetItemsByTag(appId int64, tags ...string) (*ItemList, error) {
+	params := map[string]interface{}{
+		"filters": ItemFilter{}.Tags(tags...),
+	}
+	return client.FilterItems(appId, params)
+}
@@ -0,0 +1,208 @@
+package podio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Task describes a Podio task object
+type Task struct {
+	Id          int64           `json:"task_id"`
+	ExternalId  string          `json:"external_id"`
+	Text        string          `json:"text"`
+	Description string          `json:"description"`
+	Status      string          `json:"status"` // active, completed or deleted
+	Private     bool            `json:"private"`
+	Link        string          `json:"link"`
+	SpaceId     int64           `json:"space_id"`
+	Ref         *Reference      `json:"ref"`
+	Files       []*File         `json:"files"`
+	Labels      []TaskLabel     `json:"labels"`
+	Reminder    *TaskReminder   `json:"reminder"`
+	Recurrence  *TaskRecurrence `json:"recurrence"`
+	Responsible *Contact        `json:"responsible"`
+
+	// DueDate is the date (2006-01-02) and DueTime the optional time (15:04:05)
+	// the task is due in the local time of the user. DueOn is the same in UTC.
+	DueDate string `json:"due_date"`
+	DueTime string `json:"due_time"`
+	DueOn   *Time  `json:"due_on"`
+
+	CreatedBy   ByLine  `json:"created_by"`
+	CreatedVia  Via     `json:"created_via"`
+	CreatedOn   Time    `json:"created_on"`
+	CompletedBy *ByLine `json:"completed_by"`
+	CompletedOn *Time   `json:"completed_on"`
+
+	Push Push `json:"push"`
+}
+
+// TaskLabel is a user defined label which can be put on tasks
+type TaskLabel struct {
+	Id    int64  `json:"label_id"`
+	Text  string `json:"text"`
+	Color string `json:"color"`
+}
+
+// TaskReminder describes when the responsible is reminded of a task
+type TaskReminder struct {
+	Id int64 `json:"reminder_id"`
+	// Number of minutes before the due date to send the reminder
+	RemindDelta int `json:"remind_delta"`
+}
+
+// TaskRecurrence describes how a task is repeated once it is completed
+type TaskRecurrence struct {
+	Id     int64                  `json:"recurrence_id"`
+	Name   string                 `json:"name"` // weekly, monthly or yearly
+	Config map[string]interface{} `json:"config"`
+	Step   int                    `json:"step"`
+	Until  string                 `json:"until"`
+}
+
+// https://developers.podio.com/doc/tasks/create-task-22419
+//
+// text is the title of the task.
+// Additional parameters (description, due_date, responsible, labels, ...) can be set in the params map.
+func (client *Client) CreateTask(text string, params map[string]interface{}) (task *Task, err error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["text"] = text
+
+	err = client.RequestWithParams("POST", "/task/", nil, params, &task)
+	return
+}
+
+// https://developers.podio.com/doc/tasks/create-task-with-reference-22420
+//
+// ref identifies the podio object (item, status, space, ...) the task is attached to.
+func (client *Client) CreateTaskWithReference(ref Ref, text string, params map[string]interface{}) (task *Task, err error) {
+	if err = ref.Validate(); err != nil {
+		return
+	}
+	path := fmt.Sprintf("/task/%s/%d/", ref.Type, ref.Id)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["text"] = text
+
+	err = client.RequestWithParams("POST", path, nil, params, &task)
+	return
+}
+
+// https://developers.podio.com/doc/tasks/get-task-22413
+func (client *Client) GetTask(taskId int64) (task *Task, err error) {
+	path := fmt.Sprintf("/task/%d", taskId)
+	err = client.Request("GET", path, nil, nil, &task)
+	return
+}
+
+// GetTasks returns the tasks matching the filters in params.
+// Podio requires at least one of the filters
+//
+//	responsible: user id of the responsible, or 0 for the active user
+//	due_date: date range, e.g. "2016-01-01-2016-01-31"
+//	completed: true or false
+//	reference: the object the tasks are attached to, e.g. NewRef(RefItem, 1234).String()
+//
+// to be set. limit, offset, sort_by and sort_desc control the paging.
+//
+// https://developers.podio.com/doc/tasks/get-tasks-77949
+func (client *Client) GetTasks(params map[string]interface{}) (tasks []*Task, err error) {
+	err = client.RequestWithParams("GET", "/task/", nil, params, &tasks)
+	return
+}
+
+// https://developers.podio.com/doc/tasks/update-task-10583674
+func (client *Client) UpdateTask(taskId int64, params map[string]interface{}) error {
+	path := fmt.Sprintf("/task/%d", taskId)
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
+// https://developers.podio.com/doc/tasks/complete-task-22432
+func (client *Client) CompleteTask(taskId int64) error {
+	path := fmt.Sprintf("/task/%d/complete", taskId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// https://developers.podio.com/doc/tasks/incomplete-task-22433
+func (client *Client) IncompleteTask(taskId int64) error {
+	path := fmt.Sprintf("/task/%d/incomplete", taskId)
+	return client.Request("POST", path, nil, nil, nil)
+}
+
+// AssignTask makes userId responsible for the task. A userId of 0 unassigns the task.
+//
+// https://developers.podio.com/doc/tasks/assign-task-22421
+func (client *Client) AssignTask(taskId int64, userId int64) error {
+	path := fmt.Sprintf("/task/%d/assign", taskId)
+	params := map[string]interface{}{}
+	if userId != 0 {
+		params["responsible"] = userId
+	}
+
+	return client.RequestWithParams("POST", path, nil, params, nil)
+}
+
+// https://developers.podio.com/doc/tasks/delete-task-77179
+func (client *Client) DeleteTask(taskId int64) error {
+	path := fmt.Sprintf("/task/%d", taskId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// https://developers.podio.com/doc/tasks/get-labels-151534
+func (client *Client) GetTaskLabels() (labels []TaskLabel, err error) {
+	err = client.Request("GET", "/task/label/", nil, nil, &labels)
+	return
+}
+
+// https://developers.podio.com/doc/tasks/create-label-151265
+func (client *Client) CreateTaskLabel(text, color string) (int64, error) {
+	params := map[string]interface{}{
+		"text":  text,
+		"color": color,
+	}
+
+	rsp := &struct {
+		LabelId int64 `json:"label_id"`
+	}{}
+	err := client.RequestWithParams("POST", "/task/label/", nil, params, rsp)
+
+	return rsp.LabelId, err
+}
+
+// https://developers.podio.com/doc/tasks/update-label-151289
+func (client *Client) UpdateTaskLabel(labelId int64, text, color string) error {
+	path := fmt.Sprintf("/task/label/%d", labelId)
+	params := map[string]interface{}{
+		"text":  text,
+		"color": color,
+	}
+
+	return client.RequestWithParams("PUT", path, nil, params, nil)
+}
+
+// https://developers.podio.com/doc/tasks/delete-label-151302
+func (client *Client) DeleteTaskLabel(labelId int64) error {
+	path := fmt.Sprintf("/task/label/%d", labelId)
+	return client.Request("DELETE", path, nil, nil, nil)
+}
+
+// UpdateTaskLabels replaces the labels on a task with the given labels.
+//
+// https://developers.podio.com/doc/tasks/update-task-labels-151769
+func (client *Client) UpdateTaskLabels(taskId int64, labelIds []int64) error {
+	path := fmt.Sprintf("/task/%d/label/", taskId)
+	if labelIds == nil {
+		labelIds = []int64{}
+	}
+
+	buf, err := json.Marshal(labelIds)
+	if err != nil {
+		return err
+	}
+
+	return client.Request("PUT", path, nil, bytes.NewReader(buf), nil)
+}
@@ -0,0 +1,385 @@
+package podio
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// UpsertAction is what UpsertItemByExternalID did
+type UpsertAction string
+
+const (
+	UpsertCreated   UpsertAction = "created"
+	UpsertUpdated   UpsertAction = "updated"
+	UpsertUnchanged UpsertAction = "unchanged"
+)
+
+// UpsertOptions are the optional parameters of UpsertItemByExternalID
+type UpsertOptions struct {
+	// GuardDuplicates looks the item up by filtering the app on the external id
+	// and fails with *DuplicateExternalIdError if more than one item has it,
+	// instead of updating whichever one podio returns.
+	//
+	// This only detects duplicates which already exist. It does not prevent
+	// them: two upserts racing between the lookup and the create both create
+	// an item, which a later upsert then reports.
+	GuardDuplicates bool
+}
+
+// DuplicateExternalIdError is returned when an external id is expected to be
+// unique within an app but is used by several items.
+type DuplicateExternalIdError struct {
+	AppId      int64
+	ExternalId string
+	ItemIds    []int64
+}
+
+func (e *DuplicateExternalIdError) Error() string {
+	return fmt.Sprintf("external id %q is used by %d items in app %d: %v", e.ExternalId, len(e.ItemIds), e.AppId, e.ItemIds)
+}
+
+// UpsertItemByExternalID updates the item in an app with the given external id,
+// or creates it if there is none. Only the fields whose values differ from
+// those of the item are updated, and if none do, the item is left untouched.
+// opts may be nil.
+func (client *Client) UpsertItemByExternalID(appId int64, externalId string, fieldValues map[string]interface{}, opts *UpsertOptions) (int64, UpsertAction, error) {
+	if externalId == "" {
+		return 0, "", fmt.Errorf("upsert requires an external id")
+	}
+
+	item, err := client.findItemByExternalID(appId, externalId, opts != nil && opts.GuardDuplicates)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if item == nil {
+		itemId, err := client.CreateItem(int(appId), externalId, fieldValues)
+		return itemId, UpsertCreated, err
+	}
+
+	changed := item.ChangedFields(fieldValues)
+	if len(changed) == 0 {
+		return item.Id, UpsertUnchanged, nil
+	}
+	return item.Id, UpsertUpdated, client.UpdateItem(int(item.Id), changed)
+}
+
+// findItemByExternalID returns the item with the external id, or nil if there is none
+func (client *Client) findItemByExternalID(appId int64, externalId string, guard bool) (*Item, error) {
+	if !guard {
+		item, err := client.GetItemByExternalID(appId, externalId)
+		if podioErr, ok := err.(*Error); ok && podioErr.Type == "not_found" {
+			return nil, nil
+		}
+		return item, err
+	}
+
+	items, err := client.FilterItems(appId, map[string]interface{}{
+		"filters": ItemFilter{}.ExternalId(externalId),
+		"limit":   2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case items.Filtered > 1 || len(items.Items) > 1:
+		dup := &DuplicateExternalIdError{AppId: appId, ExternalId: externalId}
+		for _, item := range items.Items {
+			dup.ItemIds = append(dup.ItemIds, item.Id)
+		}
+		return nil, dup
+	case len(items.Items) == 1:
+		return items.Items[0], nil
+	}
+	return nil, nil
+}
+
+// ChangedFields returns the entries of fieldValues, as given to UpdateItem,
+// which would change the values of the item. Fields are identified by
+// external id or field id. Values which cannot be compared to those of the
+// item, e.g. of unknown field types, are considered changed.
+func (item *Item) ChangedFields(fieldValues map[string]interface{}) map[string]interface{} {
+	changed := map[string]interface{}{}
+	for key, value := range fieldValues {
+		field := item.field(key)
+
+		want, ok := normalizeFieldValue(field, value)
+		if !ok {
+			changed[key] = value
+			continue
+		}
+
+		var have []string
+		if field != nil {
+			have, ok = currentFieldValue(field)
+			if !ok {
+				changed[key] = value
+				continue
+			}
+		}
+
+		if !equalStrings(want, have) {
+			changed[key] = value
+		}
+	}
+	return changed
+}
+
+// field returns the field with the external id or field id key, or nil if the item has no value for it
+func (item *Item) field(key string) *Field {
+	for _, field := range item.Fields {
+		if field.ExternalId == key || strconv.FormatInt(field.Id, 10) == key {
+			return field
+		}
+	}
+	return nil
+}
+
+// currentFieldValue returns the values of a field in the form of normalizeFieldValue
+func currentFieldValue(field *Field) (values []string, ok bool) {
+	switch vs := field.Values.(type) {
+	case []TextValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []NumberValue:
+		for _, v := range vs {
+			values = append(values, formatNumber(v.Value))
+		}
+	case []ProgressValue:
+		for _, v := range vs {
+			values = append(values, strconv.Itoa(v.Value))
+		}
+	case []DurationValue:
+		for _, v := range vs {
+			values = append(values, strconv.Itoa(v.Value))
+		}
+	case []MemberValue:
+		for _, v := range vs {
+			values = append(values, strconv.Itoa(v.Value))
+		}
+	case []CategoryValue:
+		for _, v := range vs {
+			values = append(values, strconv.Itoa(v.Value.Id))
+		}
+	case []AppValue:
+		for _, v := range vs {
+			values = append(values, strconv.FormatInt(v.Value.Id, 10))
+		}
+	case []ContactValue:
+		for _, v := range vs {
+			values = append(values, strconv.Itoa(v.Value.ProfileId))
+		}
+	case []ImageValue:
+		for _, v := range vs {
+			values = append(values, strconv.FormatInt(v.Value.Id, 10))
+		}
+	case []MoneyValue:
+		for _, v := range vs {
+			values = append(values, v.Currency+" "+formatNumber(v.Value))
+		}
+	case []EmailValue:
+		for _, v := range vs {
+			values = append(values, v.Type+" "+v.Value)
+		}
+	case []PhoneValue:
+		for _, v := range vs {
+			values = append(values, v.Type+" "+v.Value)
+		}
+	case []LocationValue:
+		for _, v := range vs {
+			values = append(values, v.Value)
+		}
+	case []DateValue:
+		for _, v := range vs {
+			var start, end string
+			if v.Start != nil {
+				start = v.Start.Format(podioLayout)
+			}
+			if v.End != nil && (v.Start == nil || !v.End.Equal(v.Start.Time)) {
+				end = v.End.Format(podioLayout)
+			}
+			values = append(values, start+"/"+end)
+		}
+	case []EmbedValue:
+		for _, v := range vs {
+			values = append(values, strconv.Itoa(v.Embed.Id))
+		}
+	default:
+		return nil, false
+	}
+	return values, true
+}
+
+// normalizeFieldValue turns a value as given to CreateItem and UpdateItem into
+// a list of strings comparable to the values of field. If field is nil, only
+// empty values can be normalized.
+func normalizeFieldValue(field *Field, value interface{}) (values []string, ok bool) {
+	// round trip through JSON, so all values are made of the same types
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	var generic interface{}
+	if err := json.Unmarshal(buf, &generic); err != nil {
+		return nil, false
+	}
+
+	var elems []interface{}
+	switch v := generic.(type) {
+	case nil:
+	case []interface{}:
+		elems = v
+	default:
+		elems = []interface{}{v}
+	}
+
+	if field == nil {
+		return nil, len(elems) == 0 || elems[0] == ""
+	}
+
+	for _, elem := range elems {
+		var s string
+		ok = false
+		switch field.Type {
+		case "text", "location":
+			s, ok = stringValue(elem, "value")
+		case "number":
+			s, ok = numberValue(elem, "value")
+		case "progress", "duration", "member", "app", "contact", "image":
+			s, ok = idValue(elem)
+		case "category":
+			s, ok = categoryValue(field, elem)
+		case "money":
+			if m, isMap := elem.(map[string]interface{}); isMap {
+				var currency, amount string
+				currency, ok = stringValue(m, "currency")
+				if ok {
+					amount, ok = numberValue(m, "value")
+				}
+				s = currency + " " + amount
+			}
+		case "date":
+			// only dates given in UTC can be compared
+			if m, isMap := elem.(map[string]interface{}); isMap && len(m) <= 2 {
+				var start, end string
+				start, ok = stringValue(m, "start_utc")
+				if e, hasEnd := m["end_utc"]; ok && hasEnd {
+					end, ok = stringValue(e, "")
+				} else if ok && len(m) == 2 {
+					ok = false
+				}
+				if end == start {
+					end = ""
+				}
+				s = start + "/" + end
+			}
+		case "embed":
+			if m, isMap := elem.(map[string]interface{}); isMap {
+				elem = m["embed"]
+			}
+			s, ok = idValue(elem)
+		case "email", "phone":
+			if m, isMap := elem.(map[string]interface{}); isMap {
+				var typ, v string
+				typ, ok = stringValue(m, "type")
+				if ok {
+					v, ok = stringValue(m, "value")
+				}
+				s = typ + " " + v
+			}
+		}
+		if !ok {
+			return nil, false
+		}
+		values = append(values, s)
+	}
+
+	if field.Type == "text" && len(values) == 1 && values[0] == "" {
+		values = nil
+	}
+	return values, true
+}
+
+// stringValue returns elem if it is a string, or its key entry if it is an object
+func stringValue(elem interface{}, key string) (string, bool) {
+	if m, ok := elem.(map[string]interface{}); ok {
+		elem = m[key]
+	}
+	s, ok := elem.(string)
+	return s, ok
+}
+
+// numberValue returns elem, or its key entry if it is an object, formatted as a number
+func numberValue(elem interface{}, key string) (string, bool) {
+	if m, ok := elem.(map[string]interface{}); ok {
+		elem = m[key]
+	}
+	switch v := elem.(type) {
+	case float64:
+		return formatNumber(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return "", false
+		}
+		return formatNumber(f), true
+	}
+	return "", false
+}
+
+// idValue returns elem, or its value entry if it is an object, as an integer
+func idValue(elem interface{}) (string, bool) {
+	if m, ok := elem.(map[string]interface{}); ok {
+		elem = m["value"]
+	}
+	if f, ok := elem.(float64); ok && f == float64(int64(f)) {
+		return strconv.FormatInt(int64(f), 10), true
+	}
+	return "", false
+}
+
+// categoryValue returns the id of the option elem refers to, by id or text
+func categoryValue(field *Field, elem interface{}) (string, bool) {
+	if id, ok := idValue(elem); ok {
+		return id, true
+	}
+	text, ok := elem.(string)
+	if !ok {
+		return "", false
+	}
+	settings, ok := field.Config.Settings.(CategoryFieldSettings)
+	if !ok {
+		return "", false
+	}
+	for _, option := range settings.Options {
+		if option.Text == text {
+			return strconv.Itoa(option.Id), true
+		}
+	}
+	return "", false
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// equalStrings reports whether a and b hold the same strings, regardless of order
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package podio
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangedFields(t *testing.T) {
+	r := require.New(t)
+
+	item := &Item{}
+	r.NoError(json.Unmarshal(getFixtureJSON(t, "fixtures/item_225607452.json"), item))
+
+	unchanged := map[string]interface{}{
+		"title":    "Title",
+		"category": "B",
+		"contact":  []int{140798621},
+		"number":   6513.51,
+		"money":    map[string]interface{}{"value": "541.987", "currency": "EUR"},
+		"unset":    nil,
+		"80608151": "6513.5100",
+		"date":     map[string]string{"start_utc": "2014-12-11 22:00:00"},
+		"link":     map[string]int{"embed": 55017316},
+	}
+	r.Empty(item.ChangedFields(unchanged))
+
+	changed := map[string]interface{}{
+		"title":    []map[string]string{{"value": "New title"}},
+		"category": []int{2, 3},
+		"contact":  nil,
+		"number":   1,
+		"money":    map[string]interface{}{"value": "541.987", "currency": "USD"},
+		"unset":    "value",
+		"date":     map[string]string{"start_utc": "2014-12-11 22:00:00", "end_utc": "2014-12-12 22:00:00"},
+		"80608148": map[string]string{"start": "2014-12-11 23:00:00"}, // local times are not compared
+		"link":     []int{55017317},
+	}
+	r.Equal(changed, item.ChangedFields(changed))
+}
+
+func TestUpsertItemByExternalID(t *testing.T) {
+	r := require.New(t)
+
+	var body string
+	filtered := `{"filtered": 1, "items": [{"item_id": 5, "fields": [{"field_id": 1, "external_id": "title", "type": "text", "values": [{"value": "a"}]}]}]}`
+	client := newTestClient(func(w http.ResponseWriter, req *http.Request) {
+		buf, _ := ioutil.ReadAll(req.Body)
+		body = string(buf)
+		switch req.Method {
+		case "POST":
+			w.Write([]byte(filtered))
+		case "PUT":
+			w.Write([]byte(`{}`))
+		}
+	})
+	opts := &UpsertOptions{GuardDuplicates: true}
+
+	itemId, action, err := client.UpsertItemByExternalID(1, "ext", map[string]interface{}{"title": "a"}, opts)
+	r.NoError(err)
+	r.Equal(int64(5), itemId)
+	r.Equal(UpsertUnchanged, action)
+	r.JSONEq(`{"filters": {"external_id": ["ext"]}, "limit": 2}`, body)
+
+	_, action, err = client.UpsertItemByExternalID(1, "ext", map[string]interface{}{"title": "a", "number": 2}, opts)
+	r.NoError(err)
+	r.Equal(UpsertUpdated, action)
+	r.JSONEq(`{"fields": {"number": 2}}`, body)
+
+	filtered = `{"filtered": 2, "items": [{"item_id": 5}, {"item_id": 6}]}`
+	_, _, err = client.UpsertItemByExternalID(1, "ext", nil, opts)
+	r.Equal(&DuplicateExternalIdError{AppId: 1, ExternalId: "ext", ItemIds: []int64{5, 6}}, err)
+}